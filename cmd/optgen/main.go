@@ -0,0 +1,279 @@
+// Command optgen generates patch structs for use with Optional.
+//
+// For a struct type T, it writes a TPatch struct with each exported field of
+// T wrapped in optional.Optional, an Apply method copying the present
+// fields onto a *T, and a DiffT function building the patch that turns one
+// T into another. The generated code uses no reflection.
+//
+// Usage:
+//
+//	optgen -type User[,Account...] [-output file] [dir]
+//
+// It is meant to be run by go generate:
+//
+//	//go:generate go run github.com/Palladium-blockchain/go-optional/cmd/optgen -type User
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const optionalPath = "github.com/Palladium-blockchain/go-optional/pkg/optional"
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("optgen: ")
+
+	typeNames := flag.String("type", "", "comma-separated list of struct type names; required")
+	output := flag.String("output", "", "output file name; default <dir>/<type>_patch.go")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: optgen -type T[,T...] [-output file] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *typeNames == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	types := strings.Split(*typeNames, ",")
+
+	src, err := generate(dir, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	name := *output
+	if name == "" {
+		name = filepath.Join(dir, strings.ToLower(types[0])+"_patch.go")
+	}
+	if err := os.WriteFile(name, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the patch types for the named
+// structs of the package in dir.
+func generate(dir string, typeNames []string) ([]byte, error) {
+	cfg := &packages.Config{
+		// Type-check dependencies from source rather than relying on
+		// export data, whose format depends on the Go release.
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports | packages.NeedDeps |
+			packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir: dir,
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%s: expected one package, found %d", dir, len(pkgs))
+	}
+	pkg := pkgs[0]
+	if len(pkg.Errors) > 0 {
+		return nil, pkg.Errors[0]
+	}
+
+	g := &generator{pkg: pkg.Types, imports: map[string]string{optionalPath: "optional"}}
+	for _, name := range typeNames {
+		if err := g.generateType(name); err != nil {
+			return nil, err
+		}
+	}
+	return g.source()
+}
+
+type generator struct {
+	pkg     *types.Package
+	imports map[string]string // path -> name
+	body    bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.body, format, args...)
+}
+
+// qualifier records the packages referenced by field types.
+func (g *generator) qualifier(p *types.Package) string {
+	if p == g.pkg {
+		return ""
+	}
+	g.imports[p.Path()] = p.Name()
+	return p.Name()
+}
+
+type patchField struct {
+	name string
+	typ  string
+	tag  string
+	eq   equality
+}
+
+// equality is how Diff compares the old and new value of a field.
+type equality int
+
+const (
+	eqOperator  equality = iota // from.F != to.F
+	eqMethod                    // !from.F.Equal(to.F), as for time.Time
+	eqDeepEqual                 // !reflect.DeepEqual(from.F, to.F)
+)
+
+func fieldEquality(t types.Type) equality {
+	if hasEqualMethod(t) {
+		return eqMethod
+	}
+	switch t.Underlying().(type) {
+	case *types.Interface, *types.Pointer:
+		// Compare what pointers and interfaces refer to, and avoid
+		// panics on incomparable dynamic types.
+		return eqDeepEqual
+	}
+	if !types.Comparable(t) {
+		return eqDeepEqual
+	}
+	return eqOperator
+}
+
+// hasEqualMethod reports whether t has a method Equal(t) bool.
+func hasEqualMethod(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, false, nil, "Equal")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := fn.Signature()
+	return sig.Params().Len() == 1 && types.Identical(sig.Params().At(0).Type(), t) &&
+		sig.Results().Len() == 1 && types.Identical(sig.Results().At(0).Type(), types.Typ[types.Bool])
+}
+
+func (g *generator) generateType(name string) error {
+	obj := g.pkg.Scope().Lookup(name)
+	if obj == nil {
+		return fmt.Errorf("type %s not found in package %s", name, g.pkg.Name())
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return fmt.Errorf("%s is not a named type", name)
+	}
+	if named.TypeParams().Len() > 0 {
+		return fmt.Errorf("%s: generic types are not supported", name)
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return fmt.Errorf("%s is not a struct type", name)
+	}
+
+	var fields []patchField
+	for i := range st.NumFields() {
+		f := st.Field(i)
+		if !f.Exported() || f.Embedded() {
+			continue
+		}
+		fields = append(fields, patchField{
+			name: f.Name(),
+			typ:  types.TypeString(f.Type(), g.qualifier),
+			tag:  st.Tag(i),
+			eq:   fieldEquality(f.Type()),
+		})
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("%s has no exported fields", name)
+	}
+
+	patch := name + "Patch"
+	g.printf("// %s is a partial update of %s: only its present fields are applied.\n", patch, name)
+	g.printf("type %s struct {\n", patch)
+	for _, f := range fields {
+		g.printf("\t%s optional.Optional[%s]", f.name, f.typ)
+		switch {
+		case f.tag == "":
+		case strings.Contains(f.tag, "`"):
+			g.printf(" %s", strconv.Quote(f.tag))
+		default:
+			g.printf(" `%s`", f.tag)
+		}
+		g.printf("\n")
+	}
+	g.printf("}\n\n")
+
+	g.printf("// Apply copies the present fields of p onto dst.\n")
+	g.printf("func (p %s) Apply(dst *%s) {\n", patch, name)
+	for _, f := range fields {
+		g.printf("\tif v, ok := p.%s.Get(); ok {\n\t\tdst.%[1]s = v\n\t}\n", f.name)
+	}
+	g.printf("}\n\n")
+
+	g.printf("// Diff%s returns the patch that, applied to from, makes it equal to to.\n", name)
+	g.printf("func Diff%s(from, to %[1]s) %s {\n", name, patch)
+	g.printf("\tvar p %s\n", patch)
+	for _, f := range fields {
+		switch f.eq {
+		case eqOperator:
+			g.printf("\tif from.%s != to.%[1]s {\n", f.name)
+		case eqMethod:
+			g.printf("\tif !from.%s.Equal(to.%[1]s) {\n", f.name)
+		case eqDeepEqual:
+			g.imports["reflect"] = "reflect"
+			g.printf("\tif !reflect.DeepEqual(from.%s, to.%[1]s) {\n", f.name)
+		}
+		g.printf("\t\tp.%s = optional.New(to.%[1]s)\n\t}\n", f.name)
+	}
+	g.printf("\treturn p\n}\n\n")
+	return nil
+}
+
+func (g *generator) source() ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by optgen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", g.pkg.Name())
+
+	// Standard library imports first, then the rest, as goimports does.
+	var std, other []string
+	for path := range g.imports {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	slices.Sort(std)
+	slices.Sort(other)
+	buf.WriteString("import (\n")
+	for i, group := range [][]string{std, other} {
+		if i > 0 && len(std) > 0 {
+			buf.WriteString("\n")
+		}
+		for _, path := range group {
+			if name := g.imports[path]; name != filepath.Base(path) {
+				fmt.Fprintf(&buf, "\t%s %q\n", name, path)
+			} else {
+				fmt.Fprintf(&buf, "\t%q\n", path)
+			}
+		}
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(g.body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Join(errors.New("formatting generated code"), err)
+	}
+	return src, nil
+}
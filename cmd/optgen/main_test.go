@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+func TestGenerateMatchesGolden(t *testing.T) {
+	dir := filepath.Join("testdata", "user")
+	got, err := generate(dir, []string{"User"})
+	if err != nil {
+		t.Fatalf("generate: unexpected error: %v", err)
+	}
+
+	want, err := os.ReadFile(filepath.Join(dir, "user_patch.go"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Fatalf("generated code differs from testdata/user/user_patch.go; run go generate in that directory.\ngot:\n%s", got)
+	}
+}
+
+func TestGeneratedCodeCompiles(t *testing.T) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports | packages.NeedDeps |
+			packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir: filepath.Join("testdata", "user"),
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		t.Fatalf("packages.Load: %v", err)
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		t.Fatalf("testdata/user does not type-check")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	dir := filepath.Join("testdata", "user")
+	cases := []struct {
+		typ  string
+		want string
+	}{
+		{typ: "Missing", want: "type Missing not found"},
+		{typ: "NotStruct", want: "NotStruct is not a struct type"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.typ, func(t *testing.T) {
+			_, err := generate(dir, []string{tc.typ})
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("generate: got error %v, want it to contain %q", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+//go:generate go run ../.. -type User
+
+package user
+
+import (
+	"net/url"
+	"time"
+)
+
+type User struct {
+	Name     string `json:"name"`
+	Age      int    `json:"age,omitempty"`
+	Tags     []string
+	Homepage *url.URL
+	Meta     map[string]any
+	Created  time.Time
+	Extra    any
+
+	internal bool
+}
+
+type NotStruct int
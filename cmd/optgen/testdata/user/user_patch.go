@@ -0,0 +1,74 @@
+// Code generated by optgen; DO NOT EDIT.
+
+package user
+
+import (
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// UserPatch is a partial update of User: only its present fields are applied.
+type UserPatch struct {
+	Name     optional.Optional[string] `json:"name"`
+	Age      optional.Optional[int]    `json:"age,omitempty"`
+	Tags     optional.Optional[[]string]
+	Homepage optional.Optional[*url.URL]
+	Meta     optional.Optional[map[string]any]
+	Created  optional.Optional[time.Time]
+	Extra    optional.Optional[any]
+}
+
+// Apply copies the present fields of p onto dst.
+func (p UserPatch) Apply(dst *User) {
+	if v, ok := p.Name.Get(); ok {
+		dst.Name = v
+	}
+	if v, ok := p.Age.Get(); ok {
+		dst.Age = v
+	}
+	if v, ok := p.Tags.Get(); ok {
+		dst.Tags = v
+	}
+	if v, ok := p.Homepage.Get(); ok {
+		dst.Homepage = v
+	}
+	if v, ok := p.Meta.Get(); ok {
+		dst.Meta = v
+	}
+	if v, ok := p.Created.Get(); ok {
+		dst.Created = v
+	}
+	if v, ok := p.Extra.Get(); ok {
+		dst.Extra = v
+	}
+}
+
+// DiffUser returns the patch that, applied to from, makes it equal to to.
+func DiffUser(from, to User) UserPatch {
+	var p UserPatch
+	if from.Name != to.Name {
+		p.Name = optional.New(to.Name)
+	}
+	if from.Age != to.Age {
+		p.Age = optional.New(to.Age)
+	}
+	if !reflect.DeepEqual(from.Tags, to.Tags) {
+		p.Tags = optional.New(to.Tags)
+	}
+	if !reflect.DeepEqual(from.Homepage, to.Homepage) {
+		p.Homepage = optional.New(to.Homepage)
+	}
+	if !reflect.DeepEqual(from.Meta, to.Meta) {
+		p.Meta = optional.New(to.Meta)
+	}
+	if !from.Created.Equal(to.Created) {
+		p.Created = optional.New(to.Created)
+	}
+	if !reflect.DeepEqual(from.Extra, to.Extra) {
+		p.Extra = optional.New(to.Extra)
+	}
+	return p
+}
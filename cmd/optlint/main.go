@@ -0,0 +1,17 @@
+// Command optlint reports common misuse of optional.Optional. See package
+// optlint for the checks it runs.
+//
+// Usage:
+//
+//	optlint ./...
+//	go vet -vettool=$(which optlint) ./...
+package main
+
+import (
+	"github.com/Palladium-blockchain/go-optional/pkg/optlint"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(optlint.Analyzer)
+}
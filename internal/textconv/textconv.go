@@ -0,0 +1,212 @@
+// Package textconv converts values to and from their text form. It backs
+// the text, flag and environment variable support of the optional packages.
+//
+// Types implementing encoding.TextMarshaler / encoding.TextUnmarshaler use
+// those methods. Strings, byte slices, booleans, numbers, durations and URLs
+// are handled directly, and pointers to any of them are followed. Parsers
+// added with Register take precedence over all of these when parsing.
+package textconv
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	durationType = reflect.TypeFor[time.Duration]()
+	urlType      = reflect.TypeFor[url.URL]()
+)
+
+// Format returns the text form of v.
+func Format(v any) ([]byte, error) {
+	return Append(nil, v)
+}
+
+// Append appends the text form of v to dst.
+func Append(dst []byte, v any) ([]byte, error) {
+	if out, ok := AppendScalar(dst, v); ok {
+		return out, nil
+	}
+	switch v := v.(type) {
+	case encoding.TextAppender:
+		return v.AppendText(dst)
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		return append(dst, text...), err
+	}
+	return appendReflect(dst, v)
+}
+
+// AppendScalar appends the text form of v if it is a string, byte slice,
+// bool or number of a predeclared type, and reports whether it did. It does
+// not retain v, so boxing a value to pass it in does not allocate.
+func AppendScalar(dst []byte, v any) ([]byte, bool) {
+	switch v := v.(type) {
+	case string:
+		return append(dst, v...), true
+	case []byte:
+		return append(dst, v...), true
+	case bool:
+		return strconv.AppendBool(dst, v), true
+	case int:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int8:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int16:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int32:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int64:
+		return strconv.AppendInt(dst, v, 10), true
+	case uint:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint8:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint16:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint32:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint64:
+		return strconv.AppendUint(dst, v, 10), true
+	case float32:
+		return strconv.AppendFloat(dst, float64(v), 'g', -1, 32), true
+	case float64:
+		return strconv.AppendFloat(dst, v, 'g', -1, 64), true
+	}
+	return dst, false
+}
+
+func appendReflect(dst []byte, v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return dst, fmt.Errorf("optional: cannot marshal %T as text", v)
+	}
+	switch rv.Type() {
+	case durationType:
+		return append(dst, time.Duration(rv.Int()).String()...), nil
+	case urlType:
+		u := rv.Interface().(url.URL)
+		return append(dst, u.String()...), nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return dst, fmt.Errorf("optional: cannot marshal nil %T as text", v)
+		}
+		return Append(dst, rv.Elem().Interface())
+	case reflect.String:
+		return append(dst, rv.String()...), nil
+	case reflect.Bool:
+		return strconv.AppendBool(dst, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(dst, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(dst, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(dst, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return append(dst, rv.Bytes()...), nil
+		}
+	}
+	return dst, fmt.Errorf("optional: cannot marshal %T as text", v)
+}
+
+// parsers maps a reflect.Type to the func(string) (any, error) registered
+// for it.
+var parsers sync.Map
+
+// Register makes Parse use parse for values of type t. parse must return a
+// value assignable to t. A later call for the same type replaces the
+// parser.
+func Register(t reflect.Type, parse func(string) (any, error)) {
+	parsers.Store(t, parse)
+}
+
+// Parse parses s into the value dst points to.
+func Parse(dst any, s string) error {
+	if parse, ok := parsers.Load(reflect.TypeOf(dst).Elem()); ok {
+		v, err := parse.(func(string) (any, error))(s)
+		if err != nil {
+			return err
+		}
+		rv := reflect.ValueOf(dst).Elem()
+		if v == nil {
+			rv.SetZero()
+		} else {
+			rv.Set(reflect.ValueOf(v))
+		}
+		return nil
+	}
+	if u, ok := dst.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	rv := reflect.ValueOf(dst).Elem()
+	switch rv.Type() {
+	case durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(int64(d))
+		return nil
+	case urlType:
+		u, err := url.Parse(s)
+		if err != nil {
+			return err
+		}
+		rv.Set(reflect.ValueOf(*u))
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(rv.Type().Elem())
+		if err := Parse(elem.Interface(), s); err != nil {
+			return err
+		}
+		rv.Set(elem)
+		return nil
+	case reflect.String:
+		rv.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+		return nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			rv.SetBytes([]byte(s))
+			return nil
+		}
+	}
+	return fmt.Errorf("optional: cannot unmarshal text into %s", rv.Type())
+}
@@ -0,0 +1,93 @@
+package textconv
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatAndParseURL(t *testing.T) {
+	const raw = "https://example.com/a?b=c"
+
+	var u url.URL
+	if err := Parse(&u, raw); err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if u.Host != "example.com" || u.RawQuery != "b=c" {
+		t.Fatalf("Parse: got %+v", u)
+	}
+	if got, err := Format(u); err != nil || string(got) != raw {
+		t.Fatalf("Format: got (%q, %v), want (%q, nil)", got, err, raw)
+	}
+
+	var p *url.URL
+	if err := Parse(&p, raw); err != nil {
+		t.Fatalf("Parse(*url.URL): unexpected error: %v", err)
+	}
+	if p == nil || p.String() != raw {
+		t.Fatalf("Parse(*url.URL): got %v, want %s", p, raw)
+	}
+	if got, err := Format(p); err != nil || string(got) != raw {
+		t.Fatalf("Format(*url.URL): got (%q, %v), want (%q, nil)", got, err, raw)
+	}
+}
+
+func TestParseInvalidURL(t *testing.T) {
+	var u url.URL
+	if err := Parse(&u, "http://[::1"); err == nil {
+		t.Fatalf("Parse: expected error for invalid URL")
+	}
+}
+
+func TestPointers(t *testing.T) {
+	var d *time.Duration
+	if err := Parse(&d, "1h"); err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if d == nil || *d != time.Hour {
+		t.Fatalf("Parse: got %v, want pointer to 1h", d)
+	}
+	if got, err := Format(d); err != nil || string(got) != "1h0m0s" {
+		t.Fatalf("Format: got (%q, %v), want (\"1h0m0s\", nil)", got, err)
+	}
+	if _, err := Format((*int)(nil)); err == nil {
+		t.Fatalf("Format(nil pointer): expected error")
+	}
+}
+
+func TestAppend(t *testing.T) {
+	got, err := Append([]byte("d="), 2*time.Second)
+	if err != nil || string(got) != "d=2s" {
+		t.Fatalf("Append: got (%q, %v), want (\"d=2s\", nil)", got, err)
+	}
+	if _, ok := AppendScalar(nil, time.Second); ok {
+		t.Fatalf("AppendScalar: named types should not be handled")
+	}
+	if got, ok := AppendScalar([]byte("n="), float32(0.1)); !ok || string(got) != "n=0.1" {
+		t.Fatalf("AppendScalar: got (%q, %v), want (\"n=0.1\", true)", got, ok)
+	}
+}
+
+type celsius float64
+
+func TestRegister(t *testing.T) {
+	Register(reflect.TypeFor[celsius](), func(s string) (any, error) {
+		f, err := strconv.ParseFloat(strings.TrimSuffix(s, "C"), 64)
+		return celsius(f), err
+	})
+
+	var c celsius
+	if err := Parse(&c, "21.5C"); err != nil || c != 21.5 {
+		t.Fatalf("Parse: got (%v, %v), want (21.5, nil)", c, err)
+	}
+	var p *celsius
+	if err := Parse(&p, "-3C"); err != nil || p == nil || *p != -3 {
+		t.Fatalf("Parse(*celsius): got (%v, %v), want pointer to -3", p, err)
+	}
+	if err := Parse(&c, "warm"); err == nil {
+		t.Fatalf("Parse: expected error from the registered parser")
+	}
+}
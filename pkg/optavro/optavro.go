@@ -0,0 +1,307 @@
+// Package optavro encodes structs with optional.Optional fields as Avro
+// using github.com/hamba/avro/v2, mapping each Optional[T] to the union
+// ["null", T]: an empty Optional is written as null and a null is read back
+// as an empty Optional.
+//
+// hamba/avro has no hook for custom Go types in unions, so values are
+// converted to and from an equivalent struct type in which every
+// Optional[T] is replaced by *T, which hamba/avro maps to the same union.
+// Field tags are kept, so avro struct tags work as usual.
+package optavro
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/hamba/avro/v2"
+)
+
+// Marshal encodes v, which may contain Optional fields, with schema.
+func Marshal(schema avro.Schema, v any) ([]byte, error) {
+	return MarshalWith(avro.DefaultConfig, schema, v)
+}
+
+// MarshalWith is like Marshal but encodes with the given hamba/avro API.
+func MarshalWith(api avro.API, schema avro.Schema, v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return api.Marshal(schema, v)
+	}
+	mt, err := mirrorType(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	mv := reflect.New(mt).Elem()
+	toMirror(mv, rv)
+	return api.Marshal(schema, mv.Interface())
+}
+
+// Unmarshal decodes data with schema into v, which must be a non-nil
+// pointer and may contain Optional fields.
+func Unmarshal(schema avro.Schema, data []byte, v any) error {
+	return UnmarshalWith(avro.DefaultConfig, schema, data, v)
+}
+
+// UnmarshalWith is like Unmarshal but decodes with the given hamba/avro API.
+func UnmarshalWith(api avro.API, schema avro.Schema, data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("optavro: Unmarshal: v must be a non-nil pointer, got %T", v)
+	}
+	mt, err := mirrorType(rv.Elem().Type())
+	if err != nil {
+		return err
+	}
+	mv := reflect.New(mt)
+	toMirror(mv.Elem(), rv.Elem())
+	if err := api.Unmarshal(schema, data, mv.Interface()); err != nil {
+		return err
+	}
+	fromMirror(rv.Elem(), mv.Elem())
+	return nil
+}
+
+var (
+	optionalPkgPath = reflect.TypeFor[optional.Optional[int]]().PkgPath()
+	mirrorTypes     sync.Map // map[reflect.Type]reflect.Type
+)
+
+func isOptional(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == optionalPkgPath &&
+		strings.HasPrefix(t.Name(), "Optional[")
+}
+
+// optionalElem returns the value type T of the Optional type t.
+func optionalElem(t reflect.Type) reflect.Type {
+	get, _ := t.MethodByName("Get")
+	return get.Type.Out(0)
+}
+
+// mirrorType returns the type t with every Optional[T] replaced by *T, or t
+// itself if it contains no Optionals.
+func mirrorType(t reflect.Type) (reflect.Type, error) {
+	if mt, ok := mirrorTypes.Load(t); ok {
+		return mt.(reflect.Type), nil
+	}
+	mt, err := buildMirrorType(t, map[reflect.Type]bool{})
+	if err != nil {
+		return nil, err
+	}
+	mirrorTypes.Store(t, mt)
+	return mt, nil
+}
+
+func buildMirrorType(t reflect.Type, visiting map[reflect.Type]bool) (reflect.Type, error) {
+	if isOptional(t) {
+		elem, err := buildMirrorType(optionalElem(t), visiting)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.PointerTo(elem), nil
+	}
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		if visiting[t] {
+			return t, errRecursive(t)
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		elem, err := buildMirrorType(t.Elem(), visiting)
+		if err != nil || elem == t.Elem() {
+			return t, err
+		}
+		switch t.Kind() {
+		case reflect.Pointer:
+			return reflect.PointerTo(elem), nil
+		case reflect.Slice:
+			return reflect.SliceOf(elem), nil
+		case reflect.Array:
+			return reflect.ArrayOf(t.Len(), elem), nil
+		default:
+			return reflect.MapOf(t.Key(), elem), nil
+		}
+	case reflect.Struct:
+		if visiting[t] {
+			return t, errRecursive(t)
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		var fields []reflect.StructField
+		changed := false
+		for i := range t.NumField() {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			ft, err := buildMirrorType(field.Type, visiting)
+			if err != nil {
+				return nil, err
+			}
+			changed = changed || ft != field.Type
+			field.Type = ft
+			field.Index = nil
+			field.Offset = 0
+			fields = append(fields, field)
+		}
+		if !changed {
+			return t, nil
+		}
+		return reflect.StructOf(fields), nil
+	}
+	return t, nil
+}
+
+// errRecursive reports a recursive type, which reflect.StructOf cannot
+// build a mirror of. The error is only returned for recursive types that
+// contain Optionals; others are used as they are.
+func errRecursive(t reflect.Type) error {
+	if !hasOptional(t) {
+		return nil
+	}
+	return fmt.Errorf("optavro: recursive type %s with Optional fields is not supported", t)
+}
+
+// hasOptional reports whether t contains an Optional anywhere.
+func hasOptional(t reflect.Type) bool {
+	seen := map[reflect.Type]bool{}
+	var walk func(reflect.Type) bool
+	walk = func(t reflect.Type) bool {
+		if isOptional(t) {
+			return true
+		}
+		if seen[t] {
+			return false
+		}
+		seen[t] = true
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			return walk(t.Elem())
+		case reflect.Struct:
+			for i := range t.NumField() {
+				if field := t.Field(i); field.IsExported() && walk(field.Type) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return walk(t)
+}
+
+// toMirror copies src into dst, whose type is the mirror type of src's.
+func toMirror(dst, src reflect.Value) {
+	if dst.Type() == src.Type() {
+		dst.Set(src)
+		return
+	}
+	if isOptional(src.Type()) {
+		out := src.MethodByName("Get").Call(nil)
+		if !out[1].Bool() {
+			return
+		}
+		p := reflect.New(dst.Type().Elem())
+		toMirror(p.Elem(), out[0])
+		dst.Set(p)
+		return
+	}
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		p := reflect.New(dst.Type().Elem())
+		toMirror(p.Elem(), src.Elem())
+		dst.Set(p)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		fallthrough
+	case reflect.Array:
+		for i := range src.Len() {
+			toMirror(dst.Index(i), src.Index(i))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeMapWithSize(dst.Type(), src.Len()))
+		for iter := src.MapRange(); iter.Next(); {
+			v := reflect.New(dst.Type().Elem()).Elem()
+			toMirror(v, iter.Value())
+			dst.SetMapIndex(iter.Key(), v)
+		}
+	case reflect.Struct:
+		j := 0
+		for i := range src.NumField() {
+			if !src.Type().Field(i).IsExported() {
+				continue
+			}
+			toMirror(dst.Field(j), src.Field(i))
+			j++
+		}
+	}
+}
+
+// fromMirror copies the mirror value src back into dst.
+func fromMirror(dst, src reflect.Value) {
+	if dst.Type() == src.Type() {
+		dst.Set(src)
+		return
+	}
+	if isOptional(dst.Type()) {
+		dst.SetZero()
+		if src.IsNil() {
+			return
+		}
+		v := reflect.New(optionalElem(dst.Type())).Elem()
+		fromMirror(v, src.Elem())
+		dst.Addr().MethodByName("Set").Call([]reflect.Value{v})
+		return
+	}
+	switch dst.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			dst.SetZero()
+			return
+		}
+		p := reflect.New(dst.Type().Elem())
+		fromMirror(p.Elem(), src.Elem())
+		dst.Set(p)
+	case reflect.Slice:
+		if src.IsNil() {
+			dst.SetZero()
+			return
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		fallthrough
+	case reflect.Array:
+		for i := range src.Len() {
+			fromMirror(dst.Index(i), src.Index(i))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			dst.SetZero()
+			return
+		}
+		dst.Set(reflect.MakeMapWithSize(dst.Type(), src.Len()))
+		for iter := src.MapRange(); iter.Next(); {
+			v := reflect.New(dst.Type().Elem()).Elem()
+			fromMirror(v, iter.Value())
+			dst.SetMapIndex(iter.Key(), v)
+		}
+	case reflect.Struct:
+		j := 0
+		for i := range dst.NumField() {
+			if !dst.Type().Field(i).IsExported() {
+				continue
+			}
+			fromMirror(dst.Field(i), src.Field(j))
+			j++
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package optavro
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/hamba/avro/v2"
+)
+
+var userSchema = avro.MustParse(`{
+	"type": "record",
+	"name": "User",
+	"fields": [
+		{"name": "name", "type": "string"},
+		{"name": "email", "type": ["null", "string"], "default": null},
+		{"name": "age", "type": ["null", "int"], "default": null},
+		{"name": "address", "type": ["null", {
+			"type": "record",
+			"name": "Address",
+			"fields": [
+				{"name": "city", "type": "string"},
+				{"name": "zip", "type": ["null", "string"], "default": null}
+			]
+		}], "default": null},
+		{"name": "scores", "type": {"type": "array", "items": ["null", "double"]}}
+	]
+}`)
+
+type address struct {
+	City string                    `avro:"city"`
+	Zip  optional.Optional[string] `avro:"zip"`
+}
+
+type user struct {
+	Name    string                       `avro:"name"`
+	Email   optional.Optional[string]    `avro:"email"`
+	Age     optional.Optional[int]       `avro:"age"`
+	Address optional.Optional[address]   `avro:"address"`
+	Scores  []optional.Optional[float64] `avro:"scores"`
+	cache   int
+}
+
+// The pointer-based equivalents hamba/avro supports natively.
+type ptrAddress struct {
+	City string  `avro:"city"`
+	Zip  *string `avro:"zip"`
+}
+
+type ptrUser struct {
+	Name    string      `avro:"name"`
+	Email   *string     `avro:"email"`
+	Age     *int        `avro:"age"`
+	Address *ptrAddress `avro:"address"`
+	Scores  []*float64  `avro:"scores"`
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		in   user
+		ptr  ptrUser
+	}{
+		{name: "empty", in: user{Name: "a"}, ptr: ptrUser{Name: "a"}},
+		{
+			name: "full",
+			in: user{
+				Name:    "b",
+				Email:   optional.New(""),
+				Age:     optional.New(0),
+				Address: optional.New(address{City: "x", Zip: optional.New("123")}),
+				Scores:  []optional.Optional[float64]{optional.New(1.5), optional.Empty[float64]()},
+			},
+			ptr: ptrUser{
+				Name:    "b",
+				Email:   ptr(""),
+				Age:     ptr(0),
+				Address: &ptrAddress{City: "x", Zip: ptr("123")},
+				Scores:  []*float64{ptr(1.5), nil},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := Marshal(userSchema, tc.in)
+			if err != nil {
+				t.Fatalf("Marshal: unexpected error: %v", err)
+			}
+			want, err := avro.Marshal(userSchema, tc.ptr)
+			if err != nil {
+				t.Fatalf("avro.Marshal: unexpected error: %v", err)
+			}
+			if !bytes.Equal(data, want) {
+				t.Fatalf("Marshal: got %x, want %x", data, want)
+			}
+
+			got := user{Email: optional.New("stale"), cache: 7}
+			if err := Unmarshal(userSchema, data, &got); err != nil {
+				t.Fatalf("Unmarshal: unexpected error: %v", err)
+			}
+			if got.Name != tc.in.Name || got.Email != tc.in.Email || got.Age != tc.in.Age || got.Address != tc.in.Address {
+				t.Fatalf("Unmarshal: got %+v, want %+v", got, tc.in)
+			}
+			if len(got.Scores) != len(tc.in.Scores) {
+				t.Fatalf("Unmarshal: Scores: got %v, want %v", got.Scores, tc.in.Scores)
+			}
+			for i := range got.Scores {
+				if got.Scores[i] != tc.in.Scores[i] {
+					t.Fatalf("Unmarshal: Scores: got %v, want %v", got.Scores, tc.in.Scores)
+				}
+			}
+			if got.cache != 7 {
+				t.Fatalf("Unmarshal: unexported fields should be left untouched")
+			}
+		})
+	}
+}
+
+func TestTopLevelOptional(t *testing.T) {
+	schema := avro.MustParse(`["null", "long"]`)
+	for _, in := range []optional.Optional[int64]{optional.Empty[int64](), optional.New[int64](-3)} {
+		data, err := Marshal(schema, in)
+		if err != nil {
+			t.Fatalf("Marshal(%v): unexpected error: %v", in, err)
+		}
+		var got optional.Optional[int64]
+		if err := Unmarshal(schema, data, &got); err != nil || got != in {
+			t.Fatalf("Unmarshal: got (%v, %v), want %v", got, err, in)
+		}
+	}
+}
+
+type node struct {
+	Value optional.Optional[int] `avro:"value"`
+	Next  *node                  `avro:"next"`
+}
+
+func TestRecursiveTypeWithOptional(t *testing.T) {
+	if _, err := Marshal(avro.MustParse(`"null"`), node{}); err == nil {
+		t.Fatalf("Marshal: expected error for a recursive type with Optionals")
+	}
+}
+
+func TestUnmarshalRequiresPointer(t *testing.T) {
+	if err := Unmarshal(userSchema, nil, user{}); err == nil {
+		t.Fatalf("Unmarshal: expected error for a non-pointer")
+	}
+}
@@ -0,0 +1,28 @@
+// Package optenv reads environment variables into optional.Optional values,
+// so an unset variable can be told apart from one set to the zero value.
+package optenv
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Palladium-blockchain/go-optional/internal/textconv"
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// Get reads the environment variable name and parses it into T.
+// It returns an empty Optional if the variable is unset and an error if it
+// is set but cannot be parsed. Strings, integers, floats, booleans,
+// durations, URLs, types implementing encoding.TextUnmarshaler and types
+// registered with optional.RegisterParser are supported.
+func Get[T any](name string) (optional.Optional[T], error) {
+	s, ok := os.LookupEnv(name)
+	if !ok {
+		return optional.Empty[T](), nil
+	}
+	var v T
+	if err := textconv.Parse(&v, s); err != nil {
+		return optional.Empty[T](), fmt.Errorf("optenv: %s: %w", name, err)
+	}
+	return optional.New(v), nil
+}
@@ -0,0 +1,54 @@
+package optenv
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestGetUnset(t *testing.T) {
+	o, err := Get[int]("OPTENV_TEST_UNSET")
+	if err != nil {
+		t.Fatalf("Get: unexpected error: %v", err)
+	}
+	if !o.IsEmpty() {
+		t.Fatalf("Get of unset variable should be empty")
+	}
+}
+
+func TestGetParsesValues(t *testing.T) {
+	t.Setenv("OPTENV_TEST_STRING", "")
+	t.Setenv("OPTENV_TEST_INT", "-4")
+	t.Setenv("OPTENV_TEST_BOOL", "true")
+	t.Setenv("OPTENV_TEST_DURATION", "1m")
+	t.Setenv("OPTENV_TEST_URL", "https://example.com/x")
+
+	if v, err := Get[string]("OPTENV_TEST_STRING"); err != nil || v.Or("unset") != "" {
+		t.Fatalf("string: got (%v, %v), want present empty string", v, err)
+	}
+	if v, err := Get[int64]("OPTENV_TEST_INT"); err != nil || v.Or(0) != -4 {
+		t.Fatalf("int: got (%v, %v), want -4", v, err)
+	}
+	if v, err := Get[bool]("OPTENV_TEST_BOOL"); err != nil || !v.Or(false) {
+		t.Fatalf("bool: got (%v, %v), want true", v, err)
+	}
+	if v, err := Get[time.Duration]("OPTENV_TEST_DURATION"); err != nil || v.Or(0) != time.Minute {
+		t.Fatalf("duration: got (%v, %v), want 1m", v, err)
+	}
+	u, err := Get[*url.URL]("OPTENV_TEST_URL")
+	if err != nil || u.IsEmpty() || u.MustGet().Host != "example.com" {
+		t.Fatalf("url: got (%v, %v), want host example.com", u, err)
+	}
+}
+
+func TestGetMalformed(t *testing.T) {
+	t.Setenv("OPTENV_TEST_BAD", "ten")
+
+	o, err := Get[int]("OPTENV_TEST_BAD")
+	if err == nil {
+		t.Fatalf("Get: expected error for malformed value")
+	}
+	if !o.IsEmpty() {
+		t.Fatalf("Get: malformed value should return an empty Optional")
+	}
+}
@@ -0,0 +1,173 @@
+// Package optest provides test assertions for optional.Optional values, so
+// tests do not have to repeat the Get, ok check and Fatalf sequence for
+// every Optional they inspect.
+//
+// The assertions stop the test with t.Fatalf on failure. Values are
+// compared with reflect.DeepEqual; when they differ the failure message
+// lists the differing fields, elements and map keys.
+package optest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// AssertSome fails the test unless o holds a value deeply equal to want.
+func AssertSome[T any](t testing.TB, o optional.Optional[T], want T) {
+	t.Helper()
+	if d := Diff(o, optional.New(want)); d != "" {
+		t.Fatalf("optional value mismatch:\n%s", d)
+	}
+}
+
+// AssertNone fails the test unless o is empty.
+func AssertNone[T any](t testing.TB, o optional.Optional[T]) {
+	t.Helper()
+	if v, ok := o.Get(); ok {
+		t.Fatalf("got Some(%s), want None", formatValue(reflect.ValueOf(&v).Elem()))
+	}
+}
+
+// AssertEqual fails the test unless got and want are both empty or both
+// hold deeply equal values.
+func AssertEqual[T any](t testing.TB, got, want optional.Optional[T]) {
+	t.Helper()
+	if d := Diff(got, want); d != "" {
+		t.Fatalf("optional mismatch:\n%s", d)
+	}
+}
+
+// Diff describes the differences between got and want, one per line, or
+// returns "" if they are equal. A presence mismatch is reported on its
+// own; otherwise each differing exported field, element or map key is
+// reported with its path from the value.
+func Diff[T any](got, want optional.Optional[T]) string {
+	gv, gok := got.Get()
+	wv, wok := want.Get()
+	switch {
+	case !gok && !wok:
+		return ""
+	case !gok:
+		return fmt.Sprintf("got None, want Some(%s)", formatValue(reflect.ValueOf(&wv).Elem()))
+	case !wok:
+		return fmt.Sprintf("got Some(%s), want None", formatValue(reflect.ValueOf(&gv).Elem()))
+	}
+	var lines []string
+	diffValues(&lines, "", reflect.ValueOf(&gv).Elem(), reflect.ValueOf(&wv).Elem())
+	return strings.Join(lines, "\n")
+}
+
+func diffValues(lines *[]string, path string, got, want reflect.Value) {
+	if got.Kind() == reflect.Interface {
+		got, want = got.Elem(), want.Elem()
+	}
+	if !got.IsValid() || !want.IsValid() || got.Type() != want.Type() {
+		if got.IsValid() != want.IsValid() || (got.IsValid() && got.Type() != want.Type()) {
+			addDiff(lines, path, got, want)
+		}
+		return
+	}
+	if reflect.DeepEqual(got.Interface(), want.Interface()) {
+		return
+	}
+
+	switch got.Kind() {
+	case reflect.Pointer:
+		if got.IsNil() || want.IsNil() {
+			addDiff(lines, path, got, want)
+			return
+		}
+		diffValues(lines, path, got.Elem(), want.Elem())
+		return
+	case reflect.Struct:
+		if !hasExportedFields(got.Type()) {
+			// Opaque values such as time.Time or nested Optionals.
+			break
+		}
+		n := len(*lines)
+		for i := range got.NumField() {
+			if field := got.Type().Field(i); field.IsExported() {
+				diffValues(lines, path+"."+field.Name, got.Field(i), want.Field(i))
+			}
+		}
+		if len(*lines) == n {
+			// Only unexported fields differ.
+			addDiff(lines, path, got, want)
+		}
+		return
+	case reflect.Slice, reflect.Array:
+		if got.Len() != want.Len() {
+			*lines = append(*lines, fmt.Sprintf("%s: got %d elements, want %d", pathOrValue(path), got.Len(), want.Len()))
+			return
+		}
+		for i := range got.Len() {
+			diffValues(lines, fmt.Sprintf("%s[%d]", path, i), got.Index(i), want.Index(i))
+		}
+		return
+	case reflect.Map:
+		keys := got.MapKeys()
+		for _, k := range want.MapKeys() {
+			if !got.MapIndex(k).IsValid() {
+				keys = append(keys, k)
+			}
+		}
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		})
+		for _, k := range keys {
+			keyPath := fmt.Sprintf("%s[%s]", path, formatValue(k))
+			g, w := got.MapIndex(k), want.MapIndex(k)
+			if !g.IsValid() || !w.IsValid() {
+				*lines = append(*lines, fmt.Sprintf("%s: got %s, want %s", keyPath, formatMapEntry(g), formatMapEntry(w)))
+				continue
+			}
+			diffValues(lines, keyPath, g, w)
+		}
+		return
+	}
+	addDiff(lines, path, got, want)
+}
+
+func addDiff(lines *[]string, path string, got, want reflect.Value) {
+	*lines = append(*lines, fmt.Sprintf("%s: got %s, want %s", pathOrValue(path), formatValue(got), formatValue(want)))
+}
+
+func pathOrValue(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return path
+}
+
+func formatMapEntry(v reflect.Value) string {
+	if !v.IsValid() {
+		return "no entry"
+	}
+	return formatValue(v)
+}
+
+func formatValue(v reflect.Value) string {
+	switch {
+	case !v.IsValid():
+		return "nil"
+	case v.Kind() == reflect.String:
+		return fmt.Sprintf("%q", v.String())
+	case v.Kind() == reflect.Pointer && !v.IsNil():
+		return "&" + formatValue(v.Elem())
+	}
+	return fmt.Sprintf("%v", v.Interface())
+}
+
+func hasExportedFields(t reflect.Type) bool {
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package optest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// recorder is a testing.TB that records the first Fatalf message.
+type recorder struct {
+	testing.TB
+	msg string
+}
+
+type fatal struct{}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.msg = fmt.Sprintf(format, args...)
+	panic(fatal{})
+}
+
+// run calls f with a recorder and returns the failure message, or "" if f
+// did not fail.
+func run(f func(t testing.TB)) (msg string) {
+	r := &recorder{}
+	defer func() {
+		if v := recover(); v != nil {
+			if _, ok := v.(fatal); !ok {
+				panic(v)
+			}
+			msg = r.msg
+		}
+	}()
+	f(r)
+	return ""
+}
+
+type user struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+	Email optional.Optional[string]
+	Born  time.Time
+	Boss  *user
+	note  string
+}
+
+func TestAssertSome(t *testing.T) {
+	if msg := run(func(t testing.TB) { AssertSome(t, optional.New([]int{1}), []int{1}) }); msg != "" {
+		t.Fatalf("AssertSome: unexpected failure: %s", msg)
+	}
+	msg := run(func(t testing.TB) { AssertSome(t, optional.Empty[int](), 3) })
+	if want := "got None, want Some(3)"; !strings.Contains(msg, want) {
+		t.Fatalf("AssertSome(empty): got %q, want it to contain %q", msg, want)
+	}
+	msg = run(func(t testing.TB) { AssertSome(t, optional.New("a"), "b") })
+	if want := `value: got "a", want "b"`; !strings.Contains(msg, want) {
+		t.Fatalf("AssertSome: got %q, want it to contain %q", msg, want)
+	}
+}
+
+func TestAssertNone(t *testing.T) {
+	if msg := run(func(t testing.TB) { AssertNone(t, optional.Empty[string]()) }); msg != "" {
+		t.Fatalf("AssertNone: unexpected failure: %s", msg)
+	}
+	msg := run(func(t testing.TB) { AssertNone(t, optional.New("x")) })
+	if want := `got Some("x"), want None`; msg != want {
+		t.Fatalf("AssertNone: got %q, want %q", msg, want)
+	}
+}
+
+func TestAssertEqual(t *testing.T) {
+	if msg := run(func(t testing.TB) { AssertEqual(t, optional.Empty[int](), optional.Empty[int]()) }); msg != "" {
+		t.Fatalf("AssertEqual(empty, empty): unexpected failure: %s", msg)
+	}
+	msg := run(func(t testing.TB) { AssertEqual(t, optional.New(1), optional.Empty[int]()) })
+	if want := "got Some(1), want None"; !strings.Contains(msg, want) {
+		t.Fatalf("AssertEqual: got %q, want it to contain %q", msg, want)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	born := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := user{
+		Name:  "ann",
+		Tags:  []string{"a", "b"},
+		Attrs: map[string]int{"x": 1, "y": 2},
+		Email: optional.New("a@b.c"),
+		Born:  born,
+		Boss:  &user{Name: "bob"},
+		note:  "ignored",
+	}
+	want := user{
+		Name:  "ann",
+		Tags:  []string{"a", "c"},
+		Attrs: map[string]int{"x": 1, "z": 3},
+		Email: optional.Empty[string](),
+		Born:  born.Add(time.Hour),
+		Boss:  &user{Name: "eve"},
+	}
+
+	wantDiff := strings.Join([]string{
+		`.Tags[1]: got "b", want "c"`,
+		`.Attrs["y"]: got 2, want no entry`,
+		`.Attrs["z"]: got no entry, want 3`,
+		`.Email: got Some(a@b.c), want None`,
+		`.Born: got 2000-01-01 00:00:00 +0000 UTC, want 2000-01-01 01:00:00 +0000 UTC`,
+		`.Boss.Name: got "bob", want "eve"`,
+	}, "\n")
+	if d := Diff(optional.New(got), optional.New(want)); d != wantDiff {
+		t.Fatalf("Diff:\n got %s\nwant %s", d, wantDiff)
+	}
+
+	if d := Diff(optional.New(got), optional.New(got)); d != "" {
+		t.Fatalf("Diff(equal): got %q, want \"\"", d)
+	}
+	if d := Diff(optional.New(user{note: "a"}), optional.New(user{note: "b"})); !strings.HasPrefix(d, "value: got ") {
+		t.Fatalf("Diff(unexported): got %q, want the whole values", d)
+	}
+	if d := Diff(optional.New([]int{1}), optional.New([]int{1, 2})); d != "value: got 1 elements, want 2" {
+		t.Fatalf("Diff(lengths): got %q", d)
+	}
+	if d := Diff(optional.New[any](1), optional.New[any]("1")); d != `value: got 1, want "1"` {
+		t.Fatalf("Diff(types): got %q", d)
+	}
+}
@@ -0,0 +1,23 @@
+// Package optexpvar publishes optional.Sync values through expvar.
+//
+// It is kept out of the optional package because importing expvar
+// registers /debug/vars on http.DefaultServeMux and publishes the command
+// line and memory statistics, which only programs that ask for it should
+// get.
+package optexpvar
+
+import (
+	"expvar"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// Publish exposes s through expvar under name, so it shows up on
+// /debug/vars: as null while s is empty and as the JSON encoding of its
+// value otherwise. The value is read on every request. Like
+// expvar.Publish, it panics if name is already registered.
+func Publish[T any](name string, s *optional.Sync[T]) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return s.Get()
+	}))
+}
@@ -0,0 +1,42 @@
+package optexpvar
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+func TestPublish(t *testing.T) {
+	var s optional.Sync[map[string]int]
+	Publish("optexpvar_test_publish", &s)
+
+	v := expvar.Get("optexpvar_test_publish")
+	if v == nil {
+		t.Fatalf("Publish: variable not registered")
+	}
+	if got := v.String(); got != "null" {
+		t.Fatalf("String: got %s, want null", got)
+	}
+
+	s.Set(map[string]int{"a": 1})
+	if got := v.String(); got != `{"a":1}` {
+		t.Fatalf("String: got %s, want {\"a\":1}", got)
+	}
+
+	s.Unset()
+	if got := v.String(); got != "null" {
+		t.Fatalf("String: got %s, want null after Unset", got)
+	}
+}
+
+func TestPublishDuplicatePanics(t *testing.T) {
+	var s optional.Sync[int]
+	Publish("optexpvar_test_publish_dup", &s)
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Publish: expected panic for a duplicate name")
+		}
+	}()
+	Publish("optexpvar_test_publish_dup", &s)
+}
@@ -0,0 +1,145 @@
+// Package optgopter provides github.com/leanovate/gopter generators for
+// optional.Optional and optional.TriState values.
+//
+// The element generator passed to each function must generate values of
+// type T exactly; gen.Int() generates int, gen.Int64() int64 and so on.
+// Shrinking first tries the empty Optional (Undefined and then Null for a
+// TriState) and then the shrinks of the element generator.
+package optgopter
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/leanovate/gopter"
+)
+
+// Optional returns a generator of Optionals that are empty half of the time
+// and otherwise hold a value from elementGen.
+func Optional[T any](elementGen gopter.Gen) gopter.Gen {
+	return Weighted[T](elementGen, 1, 1)
+}
+
+// Weighted returns a generator of Optionals that are empty and present in
+// the ratio emptyWeight:presentWeight. It panics if a weight is negative or
+// both are zero.
+func Weighted[T any](elementGen gopter.Gen, emptyWeight, presentWeight int) gopter.Gen {
+	checkWeights(emptyWeight, presentWeight)
+	return func(params *gopter.GenParameters) *gopter.GenResult {
+		if params.Rng.Intn(emptyWeight+presentWeight) < emptyWeight {
+			return gopter.NewGenResult(optional.Empty[T](), gopter.NoShrinker)
+		}
+		element := elementGen(params)
+		v, ok := element.Retrieve()
+		if !ok {
+			return gopter.NewEmptyResult(reflect.TypeFor[optional.Optional[T]]())
+		}
+		result := gopter.NewGenResult(optional.New(v.(T)), Shrinker[T](element.Shrinker))
+		if elementSieve := element.Sieve; elementSieve != nil {
+			result.Sieve = func(v any) bool {
+				x, ok := v.(optional.Optional[T]).Get()
+				return !ok || elementSieve(x)
+			}
+		}
+		return result
+	}
+}
+
+// TriState returns a generator of TriStates that are Undefined, Null or a
+// Value from elementGen, each a third of the time.
+func TriState[T any](elementGen gopter.Gen) gopter.Gen {
+	return WeightedTriState[T](elementGen, 1, 1, 1)
+}
+
+// WeightedTriState returns a generator of TriStates that are Undefined,
+// Null and a Value in the ratio undefinedWeight:nullWeight:valueWeight. It
+// panics if a weight is negative or all are zero.
+func WeightedTriState[T any](elementGen gopter.Gen, undefinedWeight, nullWeight, valueWeight int) gopter.Gen {
+	checkWeights(undefinedWeight, nullWeight, valueWeight)
+	return func(params *gopter.GenParameters) *gopter.GenResult {
+		switch n := params.Rng.Intn(undefinedWeight + nullWeight + valueWeight); {
+		case n < undefinedWeight:
+			return gopter.NewGenResult(optional.Undefined[T](), gopter.NoShrinker)
+		case n < undefinedWeight+nullWeight:
+			return gopter.NewGenResult(optional.Null[T](), TriStateShrinker[T](nil))
+		}
+		element := elementGen(params)
+		v, ok := element.Retrieve()
+		if !ok {
+			return gopter.NewEmptyResult(reflect.TypeFor[optional.TriState[T]]())
+		}
+		result := gopter.NewGenResult(optional.Value(v.(T)), TriStateShrinker[T](element.Shrinker))
+		if elementSieve := element.Sieve; elementSieve != nil {
+			result.Sieve = func(v any) bool {
+				x, ok := v.(optional.TriState[T]).Get()
+				return !ok || elementSieve(x)
+			}
+		}
+		return result
+	}
+}
+
+// Shrinker returns a shrinker for Optionals that first shrinks a present
+// value to the empty Optional and then to the shrinks of the value made by
+// elementShrinker, which may be nil.
+func Shrinker[T any](elementShrinker gopter.Shrinker) gopter.Shrinker {
+	return func(v any) gopter.Shrink {
+		x, ok := v.(optional.Optional[T]).Get()
+		if !ok {
+			return gopter.NoShrink
+		}
+		shrinks := []gopter.Shrink{once(optional.Empty[T]())}
+		if elementShrinker != nil {
+			shrinks = append(shrinks, elementShrinker(x).Map(optional.New[T]))
+		}
+		return gopter.ConcatShrinks(shrinks...)
+	}
+}
+
+// TriStateShrinker returns a shrinker for TriStates that shrinks towards
+// Undefined: a Value shrinks to Undefined, to Null and then to the shrinks
+// of the value made by elementShrinker, which may be nil; Null shrinks to
+// Undefined.
+func TriStateShrinker[T any](elementShrinker gopter.Shrinker) gopter.Shrinker {
+	return func(v any) gopter.Shrink {
+		s := v.(optional.TriState[T])
+		switch {
+		case s.IsUndefined():
+			return gopter.NoShrink
+		case s.IsNull():
+			return once(optional.Undefined[T]())
+		}
+		x, _ := s.Get()
+		shrinks := []gopter.Shrink{once(optional.Undefined[T]()), once(optional.Null[T]())}
+		if elementShrinker != nil {
+			shrinks = append(shrinks, elementShrinker(x).Map(optional.Value[T]))
+		}
+		return gopter.ConcatShrinks(shrinks...)
+	}
+}
+
+// once returns a Shrink yielding v once.
+func once(v any) gopter.Shrink {
+	done := false
+	return func() (any, bool) {
+		if done {
+			return nil, false
+		}
+		done = true
+		return v, true
+	}
+}
+
+func checkWeights(weights ...int) {
+	total := 0
+	for _, w := range weights {
+		if w < 0 {
+			panic(fmt.Sprintf("optgopter: negative weight %d", w))
+		}
+		total += w
+	}
+	if total == 0 {
+		panic("optgopter: all weights are zero")
+	}
+}
@@ -0,0 +1,113 @@
+package optgopter
+
+import (
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func sample[V any](t *testing.T, g gopter.Gen, n int) []V {
+	t.Helper()
+	params := gopter.DefaultGenParameters()
+	values := make([]V, 0, n)
+	for range n {
+		v, ok := g(params).Retrieve()
+		if !ok {
+			t.Fatalf("generator produced no value")
+		}
+		values = append(values, v.(V))
+	}
+	return values
+}
+
+func TestOptionalDistribution(t *testing.T) {
+	cases := []struct {
+		name   string
+		gen    gopter.Gen
+		lo, hi int // expected range of empty values out of 1000
+	}{
+		{name: "optional", gen: Optional[int](gen.Int()), lo: 400, hi: 600},
+		{name: "weighted", gen: Weighted[int](gen.Int(), 1, 9), lo: 50, hi: 150},
+		{name: "never empty", gen: Weighted[int](gen.Int(), 0, 1), lo: 0, hi: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			empty := 0
+			for _, o := range sample[optional.Optional[int]](t, tc.gen, 1000) {
+				if o.IsEmpty() {
+					empty++
+				}
+			}
+			if empty < tc.lo || empty > tc.hi {
+				t.Fatalf("got %d empty values out of 1000, want %d..%d", empty, tc.lo, tc.hi)
+			}
+		})
+	}
+}
+
+func TestTriStateDistribution(t *testing.T) {
+	var undefined, null, value int
+	for _, s := range sample[optional.TriState[string]](t, WeightedTriState[string](gen.AlphaString(), 2, 3, 5), 1000) {
+		switch {
+		case s.IsUndefined():
+			undefined++
+		case s.IsNull():
+			null++
+		default:
+			value++
+		}
+	}
+	if undefined < 130 || undefined > 270 || null < 220 || null > 380 || value < 420 || value > 580 {
+		t.Fatalf("got %d undefined, %d null and %d values out of 1000", undefined, null, value)
+	}
+}
+
+func TestShrinker(t *testing.T) {
+	shrinks := Shrinker[int](gen.IntShrinker)(optional.New(8)).All()
+	if len(shrinks) < 2 || shrinks[0] != optional.Empty[int]() || shrinks[1] != optional.New(0) {
+		t.Fatalf("Shrinker: got %v, want [None Some(0) ...]", shrinks)
+	}
+	if shrinks := Shrinker[int](gen.IntShrinker)(optional.Empty[int]()).All(); len(shrinks) != 0 {
+		t.Fatalf("Shrinker(empty): got %v, want no shrinks", shrinks)
+	}
+
+	ts := TriStateShrinker[int](nil)(optional.Value(3)).All()
+	if len(ts) != 2 || ts[0] != optional.Undefined[int]() || ts[1] != optional.Null[int]() {
+		t.Fatalf("TriStateShrinker: got %v, want [Undefined Null]", ts)
+	}
+}
+
+func TestPropertyShrinksToEmpty(t *testing.T) {
+	params := gopter.DefaultTestParameters()
+	params.Rng.Seed(1)
+	properties := gopter.NewProperties(params)
+	properties.Property("fails on present values", prop.ForAll(
+		func(o optional.Optional[int]) bool { return o.IsEmpty() || o.MustGet() > 1000 },
+		Optional[int](gen.IntRange(0, 100)),
+	))
+
+	var r recorder
+	properties.Run(&r)
+	if len(r.results) != 1 {
+		t.Fatalf("got %d results, want 1", len(r.results))
+	}
+	res := r.results[0]
+	if res.Passed() {
+		t.Fatalf("property passed, want a failure")
+	}
+	if got := res.Args[0].Arg; got != optional.New(0) {
+		t.Fatalf("shrunk argument: got %v, want Some(0)", got)
+	}
+}
+
+// recorder is a gopter.Reporter that keeps the results.
+type recorder struct {
+	results []*gopter.TestResult
+}
+
+func (r *recorder) ReportTestResult(_ string, result *gopter.TestResult) {
+	r.results = append(r.results, result)
+}
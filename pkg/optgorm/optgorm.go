@@ -0,0 +1,90 @@
+// Package optgorm adds GORM support for optional.Optional fields beyond the
+// sql.Scanner and driver.Valuer implementations of the optional package.
+//
+// Optional and TriState fields need no registration to be used as columns:
+// GORM derives the column type and size from T through driver.Valuer, maps
+// empty values to NULL, and skips empty Optionals and Undefined TriStates
+// in Updates the same way it skips nil pointers, while a Null TriState
+// clears the column. GORM loads NULL columns as zero values without calling
+// Scan, so a NULL TriState column reads back as Undefined rather than Null.
+//
+// Optional deliberately does not implement GormDataType, as GORM then stops
+// inferring the column size and would create integer columns that are too
+// small.
+//
+// Fields whose value type is not a database value, such as structs, maps
+// or slices, can be stored as JSON with the optjson serializer:
+//
+//	type User struct {
+//		Settings optional.Optional[Settings] `gorm:"serializer:optjson"`
+//	}
+package optgorm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+func init() {
+	schema.RegisterSerializer("optjson", JSONSerializer{})
+}
+
+// JSONSerializer is a GORM serializer, registered as optjson, that stores
+// an optional.Optional field as JSON text. Unlike GORM's json serializer it
+// keeps presence intact: an empty Optional is stored as SQL NULL and a
+// present one as its JSON encoding, even when that is JSON null, so a
+// present nil pointer or slice reads back as present.
+type JSONSerializer struct{}
+
+// optionalValue is implemented by every optional.Optional.
+type optionalValue interface {
+	IsEmpty() bool
+	MarshalJSON() ([]byte, error)
+}
+
+// Scan implements schema.SerializerInterface.
+func (JSONSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	fv := reflect.New(field.FieldType)
+	set := fv.MethodByName("Set")
+	if _, ok := fv.Elem().Interface().(optionalValue); !ok || !set.IsValid() || set.Type().NumIn() != 1 {
+		return fmt.Errorf("optgorm: optjson: field %s of type %s is not an optional.Optional", field.Name, field.FieldType)
+	}
+	if dbValue != nil {
+		var data []byte
+		switch v := dbValue.(type) {
+		case []byte:
+			data = v
+		case string:
+			data = []byte(v)
+		default:
+			return fmt.Errorf("optgorm: optjson: cannot scan %T into field %s", dbValue, field.Name)
+		}
+		v := reflect.New(set.Type().In(0))
+		if err := json.Unmarshal(data, v.Interface()); err != nil {
+			return fmt.Errorf("optgorm: optjson: field %s: %w", field.Name, err)
+		}
+		set.Call([]reflect.Value{v.Elem()})
+	}
+	field.ReflectValueOf(ctx, dst).Set(fv.Elem())
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface.
+func (JSONSerializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	o, ok := fieldValue.(optionalValue)
+	if !ok {
+		return nil, fmt.Errorf("optgorm: optjson: field %s of type %T is not an optional.Optional", field.Name, fieldValue)
+	}
+	if o.IsEmpty() {
+		return nil, nil
+	}
+	data, err := o.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("optgorm: optjson: field %s: %w", field.Name, err)
+	}
+	return string(data), nil
+}
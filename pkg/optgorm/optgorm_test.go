@@ -0,0 +1,184 @@
+package optgorm
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+	_ "modernc.org/sqlite"
+)
+
+type settings struct {
+	Theme string `json:"theme"`
+}
+
+type user struct {
+	ID       uint
+	Name     optional.Optional[string]
+	Age      optional.Optional[int]
+	Email    optional.TriState[string]
+	Born     optional.Optional[time.Time]
+	Settings optional.Optional[*settings] `gorm:"serializer:optjson"`
+}
+
+func openDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	// The pure-Go modernc.org/sqlite driver keeps the tests free of cgo.
+	dialector := sqlite.New(sqlite.Config{
+		DriverName: "sqlite",
+		DSN:        filepath.Join(t.TempDir(), "test.db"),
+	})
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("Open: unexpected error: %v", err)
+	}
+	if err := db.AutoMigrate(&user{}); err != nil {
+		t.Fatalf("AutoMigrate: unexpected error: %v", err)
+	}
+	return db
+}
+
+func loadUser(t *testing.T, db *gorm.DB, id uint) user {
+	t.Helper()
+	var u user
+	if err := db.First(&u, id).Error; err != nil {
+		t.Fatalf("First: unexpected error: %v", err)
+	}
+	return u
+}
+
+func TestDataTypes(t *testing.T) {
+	s, err := schema.Parse(&user{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	cases := []struct {
+		field    string
+		dataType schema.DataType
+		size     int
+	}{
+		{field: "Name", dataType: schema.String},
+		{field: "Age", dataType: schema.Int, size: 64},
+		{field: "Email", dataType: schema.String},
+		{field: "Born", dataType: schema.Time},
+		{field: "Settings", dataType: schema.String},
+	}
+	for _, tc := range cases {
+		f := s.LookUpField(tc.field)
+		if f == nil {
+			t.Fatalf("field %s not found", tc.field)
+		}
+		if f.DataType != tc.dataType || f.Size != tc.size {
+			t.Fatalf("%s: got (type=%q, size=%d), want (%q, %d)", tc.field, f.DataType, f.Size, tc.dataType, tc.size)
+		}
+		if f.NotNull {
+			t.Fatalf("%s: expected a nullable column", tc.field)
+		}
+	}
+}
+
+func TestCreateAndFirst(t *testing.T) {
+	db := openDB(t)
+	born := time.Date(1990, 5, 6, 0, 0, 0, 0, time.UTC)
+	in := user{Name: optional.New("Ann"), Born: optional.New(born)}
+	if err := db.Create(&in).Error; err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+
+	var nulls int
+	db.Raw("SELECT COUNT(*) FROM users WHERE age IS NULL AND email IS NULL AND settings IS NULL").Scan(&nulls)
+	if nulls != 1 {
+		t.Fatalf("empty fields should be stored as NULL")
+	}
+
+	got := loadUser(t, db, in.ID)
+	if v, ok := got.Name.Get(); !ok || v != "Ann" {
+		t.Fatalf("Name: got (v=%q, ok=%v), want (\"Ann\", true)", v, ok)
+	}
+	if v, ok := got.Born.Get(); !ok || !v.Equal(born) {
+		t.Fatalf("Born: got (v=%v, ok=%v), want (%v, true)", v, ok, born)
+	}
+	if !got.Age.IsEmpty() || !got.Settings.IsEmpty() {
+		t.Fatalf("NULL columns should load as empty Optionals")
+	}
+	// GORM resets fields to their zero value for NULL instead of calling
+	// Scan, so the TriState loads as Undefined.
+	if !got.Email.IsUndefined() {
+		t.Fatalf("Email: got %v, want Undefined", got.Email)
+	}
+}
+
+func TestUpdatesSkipsEmpty(t *testing.T) {
+	db := openDB(t)
+	in := user{Name: optional.New("Ann"), Age: optional.New(30), Email: optional.Value("ann@example.com")}
+	if err := db.Create(&in).Error; err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+
+	// Age is set to a zero value, Email is cleared and Name is left alone.
+	err := db.Model(&user{ID: in.ID}).Updates(user{Age: optional.New(0), Email: optional.Null[string]()}).Error
+	if err != nil {
+		t.Fatalf("Updates: unexpected error: %v", err)
+	}
+
+	got := loadUser(t, db, in.ID)
+	if v, ok := got.Name.Get(); !ok || v != "Ann" {
+		t.Fatalf("Name: got (v=%q, ok=%v), want (\"Ann\", true)", v, ok)
+	}
+	if v, ok := got.Age.Get(); !ok || v != 0 {
+		t.Fatalf("Age: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+	var cleared int
+	db.Raw("SELECT COUNT(*) FROM users WHERE id = ? AND email IS NULL", in.ID).Scan(&cleared)
+	if cleared != 1 {
+		t.Fatalf("Email: a Null TriState should clear the column")
+	}
+}
+
+func TestJSONSerializer(t *testing.T) {
+	db := openDB(t)
+	cases := []struct {
+		name string
+		in   optional.Optional[*settings]
+	}{
+		{name: "empty", in: optional.Empty[*settings]()},
+		{name: "nil", in: optional.New[*settings](nil)},
+		{name: "value", in: optional.New(&settings{Theme: "dark"})},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := user{Settings: tc.in}
+			if err := db.Create(&in).Error; err != nil {
+				t.Fatalf("Create: unexpected error: %v", err)
+			}
+			got := loadUser(t, db, in.ID).Settings
+			want, wantOk := tc.in.Get()
+			v, ok := got.Get()
+			if ok != wantOk || (v == nil) != (want == nil) || (v != nil && *v != *want) {
+				t.Fatalf("Settings: got (v=%v, ok=%v), want (%v, %v)", v, ok, want, wantOk)
+			}
+		})
+	}
+}
+
+func TestJSONSerializerRejectsNonOptional(t *testing.T) {
+	type bad struct {
+		ID   uint
+		Tags []string `gorm:"serializer:optjson"`
+	}
+	db := openDB(t)
+	if err := db.AutoMigrate(&bad{}); err != nil {
+		t.Fatalf("AutoMigrate: unexpected error: %v", err)
+	}
+	if err := db.Create(&bad{Tags: []string{"a"}}).Error; err == nil {
+		t.Fatalf("Create: expected error for a non-Optional field")
+	}
+}
@@ -0,0 +1,59 @@
+package optional
+
+import "sync/atomic"
+
+// Atomic holds an Optional that can be read and written concurrently
+// without locks. The zero value is empty and ready to use.
+//
+// Atomic must not be copied after first use.
+type Atomic[T any] struct {
+	_ noCopy
+	p atomic.Pointer[T]
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() Optional[T] {
+	return FromPtr(a.p.Load())
+}
+
+// Store replaces the current value with o.
+func (a *Atomic[T]) Store(o Optional[T]) {
+	a.p.Store(o.ToPtr())
+}
+
+// Swap stores o and returns the previous value.
+func (a *Atomic[T]) Swap(o Optional[T]) Optional[T] {
+	return FromPtr(a.p.Swap(o.ToPtr()))
+}
+
+// CompareAndSwap stores new if the current value equals old, and reports
+// whether it did. Like atomic.Value.CompareAndSwap, it panics if T is not
+// comparable.
+func (a *Atomic[T]) CompareAndSwap(old, new Optional[T]) bool {
+	next := new.ToPtr()
+	for {
+		cur := a.p.Load()
+		if !equalAny(FromPtr(cur), old) {
+			return false
+		}
+		if a.p.CompareAndSwap(cur, next) {
+			return true
+		}
+	}
+}
+
+// equalAny is Equal for types that are only known to be comparable at run
+// time.
+func equalAny[T any](a, b Optional[T]) bool {
+	if a.hasValue != b.hasValue {
+		return false
+	}
+	return !a.hasValue || any(a.value) == any(b.value)
+}
+
+// noCopy lets go vet's copylocks check flag copies of the types embedding
+// it.
+type noCopy struct{}
+
+func (*noCopy) Lock()   {}
+func (*noCopy) Unlock() {}
@@ -0,0 +1,84 @@
+package optional
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicZeroValue(t *testing.T) {
+	var a Atomic[int]
+	if !a.Load().IsEmpty() {
+		t.Fatalf("Load: zero Atomic should be empty")
+	}
+}
+
+func TestAtomicStoreSwap(t *testing.T) {
+	var a Atomic[string]
+	a.Store(New("a"))
+	if v, ok := a.Load().Get(); !ok || v != "a" {
+		t.Fatalf("Load: got (v=%q, ok=%v), want (\"a\", true)", v, ok)
+	}
+
+	prev := a.Swap(Empty[string]())
+	if v, ok := prev.Get(); !ok || v != "a" {
+		t.Fatalf("Swap: got (v=%q, ok=%v), want (\"a\", true)", v, ok)
+	}
+	if !a.Load().IsEmpty() {
+		t.Fatalf("Load: expected empty after swapping in an empty Optional")
+	}
+}
+
+func TestAtomicCompareAndSwap(t *testing.T) {
+	var a Atomic[int]
+
+	if a.CompareAndSwap(New(1), New(2)) {
+		t.Fatalf("CompareAndSwap: should fail when current value differs from old")
+	}
+	if !a.CompareAndSwap(Empty[int](), New(1)) {
+		t.Fatalf("CompareAndSwap: should succeed from empty")
+	}
+	if !a.CompareAndSwap(New(1), New(2)) {
+		t.Fatalf("CompareAndSwap: should succeed when values are equal")
+	}
+	if v, ok := a.Load().Get(); !ok || v != 2 {
+		t.Fatalf("Load: got (v=%v, ok=%v), want (2, true)", v, ok)
+	}
+}
+
+func TestAtomicCompareAndSwapNotComparable(t *testing.T) {
+	var a Atomic[[]int]
+	a.Store(New([]int{1}))
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("CompareAndSwap: expected panic for non-comparable type")
+		}
+	}()
+	a.CompareAndSwap(New([]int{1}), Empty[[]int]())
+}
+
+func TestAtomicInitOnce(t *testing.T) {
+	var (
+		a    Atomic[int]
+		wg   sync.WaitGroup
+		wins = make(chan int, 16)
+	)
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if a.CompareAndSwap(Empty[int](), New(i)) {
+				wins <- i
+			}
+		}()
+	}
+	wg.Wait()
+	close(wins)
+
+	if len(wins) != 1 {
+		t.Fatalf("CompareAndSwap: got %d winners, want 1", len(wins))
+	}
+	if v, ok := a.Load().Get(); !ok || v != <-wins {
+		t.Fatalf("Load: value does not match the winning CompareAndSwap")
+	}
+}
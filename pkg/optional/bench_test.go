@@ -0,0 +1,67 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type benchPayload struct {
+	ID      Optional[int64]   `json:"id"`
+	Name    Optional[string]  `json:"name"`
+	Email   Optional[string]  `json:"email"`
+	Age     Optional[int]     `json:"age"`
+	Score   Optional[float64] `json:"score"`
+	Active  Optional[bool]    `json:"active"`
+	Note    Optional[string]  `json:"note"`
+	Parent  Optional[int64]   `json:"parent"`
+	Deleted Optional[bool]    `json:"deleted"`
+	Rank    Optional[int]     `json:"rank"`
+}
+
+var benchJSON = []byte(`{"id":12345,"name":"alice","email":"alice@example.com","age":30,"score":97.5,` +
+	`"active":true,"note":null,"parent":null,"deleted":false,"rank":null}`)
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var p benchPayload
+		if err := json.Unmarshal(benchJSON, &p); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalJSONNull(b *testing.B) {
+	data := []byte(" null ")
+	b.ReportAllocs()
+	for b.Loop() {
+		var o Optional[string]
+		if err := o.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalJSONLongValue(b *testing.B) {
+	data := []byte(` [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20] `)
+	b.ReportAllocs()
+	for b.Loop() {
+		var o Optional[[20]int]
+		if err := o.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	var p benchPayload
+	if err := json.Unmarshal(benchJSON, &p); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := json.Marshal(p); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+const (
+	binaryEmpty   byte = 0
+	binaryPresent byte = 1
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The encoding is a presence byte followed, for present values, by the
+// encoded value: T's own MarshalBinary if it has one, raw bytes for strings
+// and byte slices, varints for integers, little-endian for other fixed-size
+// values and encoding/gob for everything else.
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	if !o.hasValue {
+		return []byte{binaryEmpty}, nil
+	}
+	return appendBinary([]byte{binaryPresent}, o.value)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("optional: cannot unmarshal empty binary data")
+	}
+	switch data[0] {
+	case binaryEmpty:
+		if len(data) != 1 {
+			return errors.New("optional: trailing data after empty binary value")
+		}
+		o.Unset()
+		return nil
+	case binaryPresent:
+		var v T
+		if err := decodeBinary(&v, data[1:]); err != nil {
+			return err
+		}
+		o.Set(v)
+		return nil
+	}
+	return fmt.Errorf("optional: invalid binary presence byte %#x", data[0])
+}
+
+func appendBinary(dst []byte, v any) ([]byte, error) {
+	if m, ok := v.(encoding.BinaryMarshaler); ok {
+		b, err := m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, b...), nil
+	}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("optional: cannot marshal %T as binary", v)
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return append(dst, rv.String()...), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(dst, rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(dst, rv.Uint()), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return append(dst, rv.Bytes()...), nil
+		}
+	}
+	if binary.Size(v) >= 0 {
+		return binary.Append(dst, binary.LittleEndian, v)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return append(dst, buf.Bytes()...), nil
+}
+
+func decodeBinary(dst any, data []byte) error {
+	if u, ok := dst.(encoding.BinaryUnmarshaler); ok {
+		return u.UnmarshalBinary(data)
+	}
+	rv := reflect.ValueOf(dst).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(string(data))
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, size := binary.Varint(data)
+		if size <= 0 || size != len(data) || rv.OverflowInt(n) {
+			return fmt.Errorf("optional: invalid binary value for %s", rv.Type())
+		}
+		rv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, size := binary.Uvarint(data)
+		if size <= 0 || size != len(data) || rv.OverflowUint(n) {
+			return fmt.Errorf("optional: invalid binary value for %s", rv.Type())
+		}
+		rv.SetUint(n)
+		return nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			rv.SetBytes(bytes.Clone(data))
+			return nil
+		}
+	}
+	if size := binary.Size(dst); size >= 0 {
+		if size != len(data) {
+			return fmt.Errorf("optional: invalid binary value for %s", rv.Type())
+		}
+		_, err := binary.Decode(data, binary.LittleEndian, dst)
+		return err
+	}
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(dst)
+}
@@ -0,0 +1,139 @@
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Optional[int]{}
+	_ encoding.BinaryUnmarshaler = (*Optional[int])(nil)
+)
+
+func roundTripBinary[T any](t *testing.T, in Optional[T]) Optional[T] {
+	t.Helper()
+	data, err := in.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: unexpected error: %v", err)
+	}
+	var out Optional[T]
+	if err := out.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: unexpected error: %v", err)
+	}
+	return out
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	type fixed struct {
+		A int32
+		B float64
+		C bool
+	}
+	type dynamic struct {
+		Name string
+		Tags []string
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		if o := roundTripBinary(t, Empty[string]()); !o.IsEmpty() {
+			t.Fatalf("round trip of empty should be empty")
+		}
+	})
+	t.Run("string", func(t *testing.T) {
+		if v, ok := roundTripBinary(t, New("")).Get(); !ok || v != "" {
+			t.Fatalf("got (v=%q, ok=%v), want (\"\", true)", v, ok)
+		}
+	})
+	t.Run("negative int", func(t *testing.T) {
+		if v, ok := roundTripBinary(t, New(-300)).Get(); !ok || v != -300 {
+			t.Fatalf("got (v=%v, ok=%v), want (-300, true)", v, ok)
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		if v, ok := roundTripBinary(t, New[uint64](1<<63)).Get(); !ok || v != 1<<63 {
+			t.Fatalf("got (v=%v, ok=%v), want (%v, true)", v, ok, uint64(1<<63))
+		}
+	})
+	t.Run("duration", func(t *testing.T) {
+		if v, ok := roundTripBinary(t, New(time.Minute)).Get(); !ok || v != time.Minute {
+			t.Fatalf("got (v=%v, ok=%v), want (1m0s, true)", v, ok)
+		}
+	})
+	t.Run("bytes", func(t *testing.T) {
+		if v, ok := roundTripBinary(t, New([]byte{0, 1, 2})).Get(); !ok || !bytes.Equal(v, []byte{0, 1, 2}) {
+			t.Fatalf("got (v=%v, ok=%v), want ([0 1 2], true)", v, ok)
+		}
+	})
+	t.Run("fixed-size struct", func(t *testing.T) {
+		want := fixed{A: -1, B: 2.5, C: true}
+		if v, ok := roundTripBinary(t, New(want)).Get(); !ok || v != want {
+			t.Fatalf("got (v=%+v, ok=%v), want (%+v, true)", v, ok, want)
+		}
+	})
+	t.Run("gob fallback", func(t *testing.T) {
+		want := dynamic{Name: "n", Tags: []string{"a", "b"}}
+		if v, ok := roundTripBinary(t, New(want)).Get(); !ok || !reflect.DeepEqual(v, want) {
+			t.Fatalf("got (v=%+v, ok=%v), want (%+v, true)", v, ok, want)
+		}
+	})
+	t.Run("binary marshaler", func(t *testing.T) {
+		want := netip.MustParseAddr("::1")
+		if v, ok := roundTripBinary(t, New(want)).Get(); !ok || v != want {
+			t.Fatalf("got (v=%v, ok=%v), want (%v, true)", v, ok, want)
+		}
+	})
+}
+
+func TestBinaryEncodingIsCompact(t *testing.T) {
+	cases := []struct {
+		name string
+		in   encoding.BinaryMarshaler
+		want []byte
+	}{
+		{name: "empty", in: Empty[int](), want: []byte{0}},
+		{name: "small int", in: New(1), want: []byte{1, 2}},
+		{name: "string", in: New("hi"), want: []byte{1, 'h', 'i'}},
+		{name: "bool", in: New(true), want: []byte{1, 1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.in.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary: unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, tc.want) {
+				t.Fatalf("MarshalBinary: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "no data", data: nil},
+		{name: "bad presence byte", data: []byte{2}},
+		{name: "trailing data after empty", data: []byte{0, 1}},
+		{name: "truncated varint", data: []byte{1, 0x80}},
+		{name: "overflow", data: []byte{1, 0x80, 0x04}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := New[int8](1)
+			if err := o.UnmarshalBinary(tc.data); err == nil {
+				t.Fatalf("UnmarshalBinary(%v): expected error", tc.data)
+			}
+			if v, ok := o.Get(); !ok || v != 1 {
+				t.Fatalf("failed UnmarshalBinary must not modify the Optional: got (v=%v, ok=%v)", v, ok)
+			}
+		})
+	}
+}
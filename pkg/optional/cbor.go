@@ -0,0 +1,30 @@
+package optional
+
+import "github.com/fxamacker/cbor/v2"
+
+// cborNull is the encoding of the CBOR simple value null.
+const cborNull = 0xf6
+
+// MarshalCBOR implements cbor.Marshaler.
+// Empty optionals are encoded as CBOR null.
+func (o Optional[T]) MarshalCBOR() ([]byte, error) {
+	if !o.hasValue {
+		return []byte{cborNull}, nil
+	}
+	return cbor.Marshal(o.value)
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler.
+// CBOR null unsets the optional; otherwise it decodes into T and sets it.
+func (o *Optional[T]) UnmarshalCBOR(data []byte) error {
+	if len(data) == 1 && data[0] == cborNull {
+		o.Unset()
+		return nil
+	}
+	var v T
+	if err := cbor.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
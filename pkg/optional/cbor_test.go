@@ -0,0 +1,72 @@
+package optional
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+var (
+	_ cbor.Marshaler   = Optional[int]{}
+	_ cbor.Unmarshaler = (*Optional[int])(nil)
+)
+
+type cborReading struct {
+	Sensor Optional[string]  `cbor:"1,keyasint"`
+	Value  Optional[float64] `cbor:"2,keyasint"`
+	Raw    Optional[[]byte]  `cbor:"3,keyasint"`
+}
+
+func TestCBOREmptyIsNull(t *testing.T) {
+	got, err := cbor.Marshal(Empty[int]())
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, []byte{0xf6}) {
+		t.Fatalf("Marshal(empty): got %x, want f6", got)
+	}
+}
+
+func TestCBORPresentMatchesPlainEncoding(t *testing.T) {
+	got, err := cbor.Marshal(New(uint16(500)))
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+	want, _ := cbor.Marshal(uint16(500))
+	if !bytes.Equal(got, want) {
+		t.Fatalf("Marshal: got %x, want %x", got, want)
+	}
+}
+
+func TestCBORRoundTrip(t *testing.T) {
+	in := cborReading{Sensor: New("t1"), Raw: New([]byte{1, 2})}
+
+	data, err := cbor.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+
+	out := cborReading{Value: New(1.5)}
+	if err := cbor.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal: unexpected error: %v", err)
+	}
+
+	if v, ok := out.Sensor.Get(); !ok || v != "t1" {
+		t.Fatalf("Sensor: got (v=%q, ok=%v), want (\"t1\", true)", v, ok)
+	}
+	if !out.Value.IsEmpty() {
+		t.Fatalf("Value: null should unset the Optional")
+	}
+	if v, ok := out.Raw.Get(); !ok || !bytes.Equal(v, []byte{1, 2}) {
+		t.Fatalf("Raw: got (v=%v, ok=%v), want ([1 2], true)", v, ok)
+	}
+}
+
+func TestCBORUnmarshalInvalid(t *testing.T) {
+	data, _ := cbor.Marshal("text")
+	var o Optional[int]
+	if err := cbor.Unmarshal(data, &o); err == nil {
+		t.Fatalf("Unmarshal: expected error for invalid value")
+	}
+}
@@ -0,0 +1,99 @@
+package optional
+
+import "fmt"
+
+// Chain composes steps over an Optional that may fail, for validation
+// pipelines over optional input. Steps are skipped once the Chain holds an
+// error or an empty Optional, so the error returned by End is the one
+// recorded by the first failing step. The zero value holds an empty
+// Optional and no error.
+type Chain[T any] struct {
+	o   Optional[T]
+	err error
+}
+
+// ChainOf starts a Chain with o.
+func ChainOf[T any](o Optional[T]) Chain[T] {
+	return Chain[T]{o: o}
+}
+
+// Map replaces the value with f(value).
+func (c Chain[T]) Map(f func(T) T) Chain[T] {
+	if c.err != nil || !c.o.hasValue {
+		return c
+	}
+	return Chain[T]{o: New(f(c.o.value))}
+}
+
+// TryMap replaces the value with the result of f, or records the error f
+// returns. Use MapChain to change the value's type.
+func (c Chain[T]) TryMap(f func(T) (T, error)) Chain[T] {
+	return MapChain(c, f)
+}
+
+// Filter empties the Chain if the value does not satisfy pred, like
+// Optional.Filter. It does not record an error; use Check for that.
+func (c Chain[T]) Filter(pred func(T) bool) Chain[T] {
+	if c.err != nil || !c.o.hasValue || pred(c.o.value) {
+		return c
+	}
+	return Chain[T]{}
+}
+
+// Check records the error f returns for the value, if any.
+func (c Chain[T]) Check(f func(T) error) Chain[T] {
+	if c.err != nil || !c.o.hasValue {
+		return c
+	}
+	if err := f(c.o.value); err != nil {
+		return Chain[T]{err: err}
+	}
+	return c
+}
+
+// Require records err if the Chain is empty. It panics if err is nil.
+func (c Chain[T]) Require(err error) Chain[T] {
+	if err == nil {
+		panic("optional: Require called with nil error")
+	}
+	if c.err != nil || c.o.hasValue {
+		return c
+	}
+	return Chain[T]{err: err}
+}
+
+// Err returns the recorded error, or nil if no step failed.
+func (c Chain[T]) Err() error {
+	return c.err
+}
+
+// End returns the resulting Optional and the recorded error. The Optional
+// is empty if an error was recorded.
+func (c Chain[T]) End() (Optional[T], error) {
+	return c.o, c.err
+}
+
+// MustEnd is like End but panics if an error was recorded.
+func (c Chain[T]) MustEnd() Optional[T] {
+	if c.err != nil {
+		panic(fmt.Sprintf("optional: chain failed: %v", c.err))
+	}
+	return c.o
+}
+
+// MapChain replaces the value of c with the result of f, or records the
+// error f returns. A failed or empty Chain is carried over and f is not
+// called.
+func MapChain[T, U any](c Chain[T], f func(T) (U, error)) Chain[U] {
+	if c.err != nil {
+		return Chain[U]{err: c.err}
+	}
+	if !c.o.hasValue {
+		return Chain[U]{}
+	}
+	v, err := f(c.o.value)
+	if err != nil {
+		return Chain[U]{err: err}
+	}
+	return Chain[U]{o: New(v)}
+}
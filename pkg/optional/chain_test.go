@@ -0,0 +1,97 @@
+package optional
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var (
+	errTooLong  = errors.New("too long")
+	errRequired = errors.New("required")
+)
+
+func checkLength(s string) error {
+	if len(s) > 5 {
+		return errTooLong
+	}
+	return nil
+}
+
+func TestChain(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      Optional[string]
+		want    Optional[string]
+		wantErr error
+	}{
+		{name: "present", in: New(" Ab "), want: New("ab")},
+		{name: "empty", in: Empty[string](), wantErr: errRequired},
+		{name: "filtered", in: New("   "), wantErr: errRequired},
+		{name: "check fails", in: New("abcdefg"), wantErr: errTooLong},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ChainOf(tc.in).
+				Map(strings.TrimSpace).
+				Filter(func(s string) bool { return s != "" }).
+				Check(checkLength).
+				Map(strings.ToLower).
+				Require(errRequired).
+				End()
+			if got != tc.want || !errors.Is(err, tc.wantErr) {
+				t.Fatalf("End: got (%v, %v), want (%v, %v)", got, err, tc.want, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestChainFirstErrorWins(t *testing.T) {
+	errSecond := errors.New("second")
+	called := false
+	c := ChainOf(New("abcdefg")).
+		Check(checkLength).
+		TryMap(func(s string) (string, error) { return s, errSecond }).
+		Map(func(s string) string { called = true; return s })
+
+	if err := c.Err(); err != errTooLong {
+		t.Fatalf("Err: got %v, want %v", err, errTooLong)
+	}
+	if called {
+		t.Fatalf("Map: step after a failure was called")
+	}
+	if o, _ := c.End(); !o.IsEmpty() {
+		t.Fatalf("End: got %v, want empty after a failure", o)
+	}
+}
+
+func TestMapChain(t *testing.T) {
+	n, err := MapChain(ChainOf(New("42")), strconv.Atoi).End()
+	if v, ok := n.Get(); err != nil || !ok || v != 42 {
+		t.Fatalf("MapChain: got (v=%v, ok=%v, err=%v), want (42, true, nil)", v, ok, err)
+	}
+
+	_, err = MapChain(ChainOf(New("x")), strconv.Atoi).End()
+	if err == nil {
+		t.Fatalf("MapChain: expected an error for an invalid number")
+	}
+
+	n, err = MapChain(ChainOf(Empty[string]()), strconv.Atoi).End()
+	if !n.IsEmpty() || err != nil {
+		t.Fatalf("MapChain of empty: got (%v, %v), want (None, nil)", n, err)
+	}
+}
+
+func TestChainMustEnd(t *testing.T) {
+	if got := ChainOf(New(1)).MustEnd(); got != New(1) {
+		t.Fatalf("MustEnd: got %v, want Some(1)", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(string), "too long") {
+			t.Fatalf("MustEnd: got panic %v, want one mentioning the error", r)
+		}
+	}()
+	ChainOf(New("abcdefg")).Check(checkLength).MustEnd()
+}
@@ -0,0 +1,12 @@
+package optional
+
+// TryRecv receives from ch without blocking. It returns an empty Optional
+// if no value is ready, ch is closed, or ch is nil.
+func TryRecv[T any](ch <-chan T) Optional[T] {
+	select {
+	case v, ok := <-ch:
+		return FromOk(v, ok)
+	default:
+		return Optional[T]{}
+	}
+}
@@ -0,0 +1,25 @@
+package optional
+
+import "testing"
+
+func TestTryRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	if !TryRecv(ch).IsEmpty() {
+		t.Fatalf("TryRecv: expected empty when no value is ready")
+	}
+
+	ch <- 0
+	if v, ok := TryRecv(ch).Get(); !ok || v != 0 {
+		t.Fatalf("TryRecv: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+
+	close(ch)
+	if !TryRecv(ch).IsEmpty() {
+		t.Fatalf("TryRecv: expected empty for a closed channel")
+	}
+
+	var nilCh chan int
+	if !TryRecv(nilCh).IsEmpty() {
+		t.Fatalf("TryRecv: expected empty for a nil channel")
+	}
+}
@@ -0,0 +1,45 @@
+package optional
+
+import "reflect"
+
+// Clone returns a copy of o that can be modified without affecting o.
+// If the value is a slice or a map, the copy gets its own backing array or
+// map; the elements themselves are copied as by assignment, so pointers,
+// nested slices and maps are still shared. Other values are copied as by
+// assignment. Use DeepClone when the value needs a deeper copy.
+func (o Optional[T]) Clone() Optional[T] {
+	if !o.hasValue {
+		return Optional[T]{}
+	}
+	rv := reflect.ValueOf(&o.value).Elem()
+	var c reflect.Value
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			return o
+		}
+		c = reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(c, rv)
+	case reflect.Map:
+		if rv.IsNil() {
+			return o
+		}
+		c = reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), iter.Value())
+		}
+	default:
+		return o
+	}
+	var out Optional[T]
+	reflect.ValueOf(&out.value).Elem().Set(c)
+	out.hasValue = true
+	return out
+}
+
+// DeepClone returns a copy of o whose value is clone applied to the value
+// of o, for values holding references that Clone would share. An empty
+// Optional stays empty and clone is not called.
+func DeepClone[T any](o Optional[T], clone func(T) T) Optional[T] {
+	return Map(o, clone)
+}
@@ -0,0 +1,82 @@
+package optional
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestCloneSlice(t *testing.T) {
+	o := New([]int{1, 2, 3})
+	c := o.Clone()
+	c.MustGet()[0] = 10
+
+	if got := o.MustGet(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("original after modifying clone: got %v, want [1 2 3]", got)
+	}
+	if got := c.MustGet(); !slices.Equal(got, []int{10, 2, 3}) {
+		t.Fatalf("clone: got %v, want [10 2 3]", got)
+	}
+
+	if v, ok := New([]int(nil)).Clone().Get(); !ok || v != nil {
+		t.Fatalf("Clone of nil slice: got (v=%v, ok=%v), want (nil, true)", v, ok)
+	}
+	if v, ok := New([]int{}).Clone().Get(); !ok || v == nil {
+		t.Fatalf("Clone of empty slice: got (v=%v, ok=%v), want non-nil empty slice", v, ok)
+	}
+}
+
+func TestCloneMap(t *testing.T) {
+	o := New(map[string]int{"a": 1})
+	c := o.Clone()
+	c.MustGet()["b"] = 2
+
+	if got := o.MustGet(); !maps.Equal(got, map[string]int{"a": 1}) {
+		t.Fatalf("original after modifying clone: got %v, want map[a:1]", got)
+	}
+	if got := c.MustGet(); !maps.Equal(got, map[string]int{"a": 1, "b": 2}) {
+		t.Fatalf("clone: got %v, want map[a:1 b:2]", got)
+	}
+}
+
+func TestCloneOther(t *testing.T) {
+	if c := Empty[[]int]().Clone(); !c.IsEmpty() {
+		t.Fatalf("Clone of empty: got %v, want empty", c)
+	}
+	if v, ok := New("x").Clone().Get(); !ok || v != "x" {
+		t.Fatalf("Clone: got (v=%q, ok=%v), want (\"x\", true)", v, ok)
+	}
+
+	// Clone is shallow: nested slices are shared.
+	o := New([][]int{{1}})
+	o.Clone().MustGet()[0][0] = 2
+	if got := o.MustGet()[0][0]; got != 2 {
+		t.Fatalf("nested element after modifying clone: got %v, want 2", got)
+	}
+}
+
+func TestDeepClone(t *testing.T) {
+	cloneRows := func(rows [][]int) [][]int {
+		out := make([][]int, len(rows))
+		for i, row := range rows {
+			out[i] = slices.Clone(row)
+		}
+		return out
+	}
+
+	o := New([][]int{{1, 2}, {3}})
+	c := DeepClone(o, cloneRows)
+	c.MustGet()[0][0] = 10
+	if got := o.MustGet()[0][0]; got != 1 {
+		t.Fatalf("original after modifying deep clone: got %v, want 1", got)
+	}
+
+	called := false
+	e := DeepClone(Empty[[][]int](), func(v [][]int) [][]int {
+		called = true
+		return v
+	})
+	if !e.IsEmpty() || called {
+		t.Fatalf("DeepClone of empty: got (empty=%v, called=%v), want (true, false)", e.IsEmpty(), called)
+	}
+}
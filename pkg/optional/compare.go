@@ -0,0 +1,80 @@
+package optional
+
+import "cmp"
+
+// Contains reports whether o is present and holds exactly v.
+func Contains[T comparable](o Optional[T], v T) bool {
+	return o.hasValue && o.value == v
+}
+
+// Equal reports whether a and b are both empty, or both present with equal values.
+func Equal[T comparable](a, b Optional[T]) bool {
+	if a.hasValue != b.hasValue {
+		return false
+	}
+	return !a.hasValue || a.value == b.value
+}
+
+// EqualFunc is like Equal, but compares present values with eq.
+func EqualFunc[T any](a, b Optional[T], eq func(T, T) bool) bool {
+	if a.hasValue != b.hasValue {
+		return false
+	}
+	return !a.hasValue || eq(a.value, b.value)
+}
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to
+// or greater than b. Empty Optionals sort before present ones, and present
+// values are ordered with cmp.Compare.
+func Compare[T cmp.Ordered](a, b Optional[T]) int {
+	switch {
+	case !a.hasValue && !b.hasValue:
+		return 0
+	case !a.hasValue:
+		return -1
+	case !b.hasValue:
+		return +1
+	}
+	return cmp.Compare(a.value, b.value)
+}
+
+// Less reports whether a sorts before b, as defined by Compare.
+func Less[T cmp.Ordered](a, b Optional[T]) bool {
+	return Compare(a, b) < 0
+}
+
+// Min returns the smallest present value among opts, or an empty Optional
+// if none is present. Like the built-in min, it returns NaN if any present
+// value is a NaN.
+func Min[T cmp.Ordered](opts ...Optional[T]) Optional[T] {
+	var result Optional[T]
+	for _, o := range opts {
+		if !o.hasValue {
+			continue
+		}
+		if !result.hasValue {
+			result = o
+			continue
+		}
+		result.value = min(result.value, o.value)
+	}
+	return result
+}
+
+// Max returns the largest present value among opts, or an empty Optional
+// if none is present. Like the built-in max, it returns NaN if any present
+// value is a NaN.
+func Max[T cmp.Ordered](opts ...Optional[T]) Optional[T] {
+	var result Optional[T]
+	for _, o := range opts {
+		if !o.hasValue {
+			continue
+		}
+		if !result.hasValue {
+			result = o
+			continue
+		}
+		result.value = max(result.value, o.value)
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+package optional
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	cases := []struct {
+		name string
+		o    Optional[int]
+		v    int
+		want bool
+	}{
+		{name: "same value", o: New(3), v: 3, want: true},
+		{name: "different value", o: New(3), v: 4},
+		{name: "empty vs zero", o: Empty[int](), v: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Contains(tc.o, tc.v); got != tc.want {
+				t.Fatalf("Contains: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	set := New(0)
+	set.Unset()
+
+	cases := []struct {
+		name string
+		a, b Optional[int]
+		want bool
+	}{
+		{name: "both empty", a: Empty[int](), b: Empty[int](), want: true},
+		{name: "empty and unset", a: Empty[int](), b: set, want: true},
+		{name: "same value", a: New(1), b: New(1), want: true},
+		{name: "different values", a: New(1), b: New(2)},
+		{name: "empty vs zero value", a: Empty[int](), b: New(0)},
+		{name: "zero value vs empty", a: New(0), b: Empty[int]()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Equal(tc.a, tc.b); got != tc.want {
+				t.Fatalf("Equal: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	eq := func(x, y []int) bool { return slices.Equal(x, y) }
+
+	cases := []struct {
+		name string
+		a, b Optional[[]int]
+		want bool
+	}{
+		{name: "both empty", a: Empty[[]int](), b: Empty[[]int](), want: true},
+		{name: "equal slices", a: New([]int{1, 2}), b: New([]int{1, 2}), want: true},
+		{name: "different slices", a: New([]int{1, 2}), b: New([]int{2, 1})},
+		{name: "empty vs nil slice", a: Empty[[]int](), b: New([]int(nil))},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := EqualFunc(tc.a, tc.b, eq); got != tc.want {
+				t.Fatalf("EqualFunc: got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b Optional[int]
+		want int
+	}{
+		{name: "both empty", a: Empty[int](), b: Empty[int](), want: 0},
+		{name: "empty before present", a: Empty[int](), b: New(-5), want: -1},
+		{name: "present after empty", a: New(-5), b: Empty[int](), want: +1},
+		{name: "less", a: New(1), b: New(2), want: -1},
+		{name: "equal", a: New(2), b: New(2), want: 0},
+		{name: "greater", a: New(3), b: New(2), want: +1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Compare(tc.a, tc.b); got != tc.want {
+				t.Fatalf("Compare: got %v, want %v", got, tc.want)
+			}
+			if got := Less(tc.a, tc.b); got != (tc.want < 0) {
+				t.Fatalf("Less: got %v, want %v", got, tc.want < 0)
+			}
+		})
+	}
+}
+
+func TestCompareSortsSlices(t *testing.T) {
+	s := []Optional[string]{New("b"), Empty[string](), New("a")}
+	slices.SortFunc(s, Compare[string])
+
+	if !s[0].IsEmpty() || s[1].Or("") != "a" || s[2].Or("") != "b" {
+		t.Fatalf("sorted: got [%v %v %v], want [empty a b]", s[0].Or("<empty>"), s[1].Or("<empty>"), s[2].Or("<empty>"))
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       []Optional[int]
+		min, max Optional[int]
+	}{
+		{name: "no arguments", min: Empty[int](), max: Empty[int]()},
+		{name: "all empty", in: []Optional[int]{Empty[int](), Empty[int]()}, min: Empty[int](), max: Empty[int]()},
+		{name: "single", in: []Optional[int]{Empty[int](), New(4)}, min: New(4), max: New(4)},
+		{name: "mixed", in: []Optional[int]{New(3), Empty[int](), New(-1), New(7)}, min: New(-1), max: New(7)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Min(tc.in...); !Equal(got, tc.min) {
+				t.Fatalf("Min: got %v, want %v", got, tc.min)
+			}
+			if got := Max(tc.in...); !Equal(got, tc.max) {
+				t.Fatalf("Max: got %v, want %v", got, tc.max)
+			}
+		})
+	}
+}
+
+func TestMinMaxNaN(t *testing.T) {
+	in := []Optional[float64]{New(1.0), New(math.NaN()), New(2.0)}
+	if v, ok := Min(in...).Get(); !ok || !math.IsNaN(v) {
+		t.Fatalf("Min: got (v=%v, ok=%v), want (NaN, true)", v, ok)
+	}
+	if v, ok := Max(in...).Get(); !ok || !math.IsNaN(v) {
+		t.Fatalf("Max: got (v=%v, ok=%v), want (NaN, true)", v, ok)
+	}
+}
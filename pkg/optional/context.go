@@ -0,0 +1,32 @@
+package optional
+
+import "context"
+
+// ContextKey is a typed key for storing an Optional[T] in a context.
+// Keys compare by identity, so two keys with the same name never collide.
+type ContextKey[T any] struct {
+	name string
+}
+
+// NewContextKey returns a new key. The name is only used by String.
+func NewContextKey[T any](name string) *ContextKey[T] {
+	return &ContextKey[T]{name: name}
+}
+
+// String implements fmt.Stringer.
+func (k *ContextKey[T]) String() string {
+	return "optional.ContextKey(" + k.name + ")"
+}
+
+// IntoContext returns a copy of ctx carrying o under key. Storing an empty
+// Optional hides any value set for key by a parent context.
+func IntoContext[T any](ctx context.Context, key *ContextKey[T], o Optional[T]) context.Context {
+	return context.WithValue(ctx, key, o)
+}
+
+// FromContext returns the Optional stored under key, or an empty Optional
+// if ctx carries none.
+func FromContext[T any](ctx context.Context, key *ContextKey[T]) Optional[T] {
+	o, _ := ctx.Value(key).(Optional[T])
+	return o
+}
@@ -0,0 +1,35 @@
+package optional
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	userKey := NewContextKey[string]("user")
+	otherKey := NewContextKey[string]("user")
+
+	ctx := context.Background()
+	if !FromContext(ctx, userKey).IsEmpty() {
+		t.Fatalf("FromContext: expected empty Optional for missing key")
+	}
+
+	ctx = IntoContext(ctx, userKey, New("alice"))
+	if v, ok := FromContext(ctx, userKey).Get(); !ok || v != "alice" {
+		t.Fatalf("FromContext: got (v=%q, ok=%v), want (\"alice\", true)", v, ok)
+	}
+	if !FromContext(ctx, otherKey).IsEmpty() {
+		t.Fatalf("FromContext: keys with the same name should not collide")
+	}
+
+	child := IntoContext(ctx, userKey, Empty[string]())
+	if !FromContext(child, userKey).IsEmpty() {
+		t.Fatalf("FromContext: an empty Optional should hide the parent's value")
+	}
+}
+
+func TestContextKeyString(t *testing.T) {
+	if got, want := NewContextKey[int]("id").String(), "optional.ContextKey(id)"; got != want {
+		t.Fatalf("String: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,29 @@
+package optional
+
+import "github.com/Palladium-blockchain/go-optional/internal/textconv"
+
+// MarshalCSV implements the TypeMarshaller interface of
+// github.com/gocarina/gocsv. Empty optionals produce an empty cell; present
+// values are formatted the same way as MarshalText.
+func (o Optional[T]) MarshalCSV() (string, error) {
+	if !o.hasValue {
+		return "", nil
+	}
+	text, err := textconv.Format(o.value)
+	return string(text), err
+}
+
+// UnmarshalCSV implements the TypeUnmarshaller interface of
+// github.com/gocarina/gocsv. An empty cell unsets the optional.
+func (o *Optional[T]) UnmarshalCSV(cell string) error {
+	if cell == "" {
+		o.Unset()
+		return nil
+	}
+	var v T
+	if err := textconv.Parse(&v, cell); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
@@ -0,0 +1,79 @@
+package optional
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+func TestMarshalCSV(t *testing.T) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+
+	rows := [][2]Optional[int]{
+		{New(30), New(0)},
+		{Empty[int](), New(7)},
+	}
+	for _, row := range rows {
+		var record []string
+		for _, cell := range row {
+			s, err := cell.MarshalCSV()
+			if err != nil {
+				t.Fatalf("MarshalCSV: unexpected error: %v", err)
+			}
+			record = append(record, s)
+		}
+		if err := w.Write(record); err != nil {
+			t.Fatalf("Write: unexpected error: %v", err)
+		}
+	}
+	w.Flush()
+
+	if got, want := b.String(), "30,0\n,7\n"; got != want {
+		t.Fatalf("csv output: got %q, want %q", got, want)
+	}
+}
+
+func TestUnmarshalCSV(t *testing.T) {
+	records, err := csv.NewReader(strings.NewReader("a,30,\nb,,1.5\n")).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll: unexpected error: %v", err)
+	}
+
+	type row struct {
+		Age   Optional[int]
+		Score Optional[float64]
+	}
+	rows := make([]row, len(records))
+	for i, record := range records {
+		if err := rows[i].Age.UnmarshalCSV(record[1]); err != nil {
+			t.Fatalf("UnmarshalCSV(age): unexpected error: %v", err)
+		}
+		if err := rows[i].Score.UnmarshalCSV(record[2]); err != nil {
+			t.Fatalf("UnmarshalCSV(score): unexpected error: %v", err)
+		}
+	}
+
+	if v, ok := rows[0].Age.Get(); !ok || v != 30 {
+		t.Fatalf("rows[0].Age: got (v=%v, ok=%v), want (30, true)", v, ok)
+	}
+	if !rows[0].Score.IsEmpty() {
+		t.Fatalf("rows[0].Score: empty cell should give an empty Optional")
+	}
+	if !rows[1].Age.IsEmpty() {
+		t.Fatalf("rows[1].Age: empty cell should give an empty Optional")
+	}
+	if v, ok := rows[1].Score.Get(); !ok || v != 1.5 {
+		t.Fatalf("rows[1].Score: got (v=%v, ok=%v), want (1.5, true)", v, ok)
+	}
+}
+
+func TestUnmarshalCSVInvalid(t *testing.T) {
+	o := New(1)
+	if err := o.UnmarshalCSV("x"); err == nil {
+		t.Fatalf("UnmarshalCSV: expected error for invalid cell")
+	}
+	if v, ok := o.Get(); !ok || v != 1 {
+		t.Fatalf("failed UnmarshalCSV must not modify the Optional: got (v=%v, ok=%v)", v, ok)
+	}
+}
@@ -0,0 +1,36 @@
+package optional
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ApplyDefaults fills every empty Optional field of the struct v points to
+// with the value of its `default` tag, parsed like UnmarshalText:
+//
+//	type Config struct {
+//		Addr    Optional[string]        `default:":8080"`
+//		Timeout Optional[time.Duration] `default:"5s"`
+//	}
+//
+// Fields that are already present, or have no default tag, are left alone.
+// Nested struct fields are visited too.
+func ApplyDefaults(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("optional: ApplyDefaults: expected a non-nil pointer to a struct, got %T", v)
+	}
+	return walkOptionalFields(rv.Elem(), "", func(field reflect.StructField, fv reflect.Value, name string) error {
+		def, ok := field.Tag.Lookup("default")
+		if !ok {
+			return nil
+		}
+		if _, present := fv.Interface().(anyOptional).anyValue(); present {
+			return nil
+		}
+		if err := setOptionalFromText(fv, def); err != nil {
+			return fmt.Errorf("optional: ApplyDefaults: field %s: %w", name, err)
+		}
+		return nil
+	})
+}
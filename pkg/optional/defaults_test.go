@@ -0,0 +1,64 @@
+package optional
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type defaultsConfig struct {
+	Addr    Optional[string]        `default:":8080"`
+	Timeout Optional[time.Duration] `default:"5s"`
+	Debug   Optional[bool]          `default:"true"`
+	Retries Optional[int]           `default:"3"`
+	Name    Optional[string]
+	DB      struct {
+		Port Optional[uint16] `default:"5432"`
+	}
+	Plain string `default:"ignored"`
+}
+
+func TestApplyDefaults(t *testing.T) {
+	cfg := defaultsConfig{Retries: New(0)}
+	if err := ApplyDefaults(&cfg); err != nil {
+		t.Fatalf("ApplyDefaults: unexpected error: %v", err)
+	}
+
+	if v, ok := cfg.Addr.Get(); !ok || v != ":8080" {
+		t.Fatalf("Addr: got (v=%q, ok=%v), want (\":8080\", true)", v, ok)
+	}
+	if v, ok := cfg.Timeout.Get(); !ok || v != 5*time.Second {
+		t.Fatalf("Timeout: got (v=%v, ok=%v), want (5s, true)", v, ok)
+	}
+	if v, ok := cfg.Debug.Get(); !ok || !v {
+		t.Fatalf("Debug: got (v=%v, ok=%v), want (true, true)", v, ok)
+	}
+	if v, ok := cfg.Retries.Get(); !ok || v != 0 {
+		t.Fatalf("Retries: present value should be kept, got (v=%v, ok=%v)", v, ok)
+	}
+	if !cfg.Name.IsEmpty() {
+		t.Fatalf("Name: field without default should stay empty")
+	}
+	if v, ok := cfg.DB.Port.Get(); !ok || v != 5432 {
+		t.Fatalf("DB.Port: got (v=%v, ok=%v), want (5432, true)", v, ok)
+	}
+	if cfg.Plain != "" {
+		t.Fatalf("Plain: non-Optional fields should be ignored, got %q", cfg.Plain)
+	}
+}
+
+func TestApplyDefaultsErrors(t *testing.T) {
+	var bad struct {
+		DB struct {
+			Port Optional[int] `default:"many"`
+		}
+	}
+	err := ApplyDefaults(&bad)
+	if err == nil || !strings.Contains(err.Error(), "field DB.Port") {
+		t.Fatalf("ApplyDefaults: got error %v, want it to name field DB.Port", err)
+	}
+
+	if err := ApplyDefaults(defaultsConfig{}); err == nil {
+		t.Fatalf("ApplyDefaults: expected error for a non-pointer argument")
+	}
+}
@@ -0,0 +1,78 @@
+package optional
+
+import "fmt"
+
+// Either holds exactly one of two values: a Left, conventionally the reason
+// a value is missing, or a Right, the value itself. The zero value is a
+// Left holding L's zero value.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left returns an Either holding the left value v.
+func Left[L, R any](v L) Either[L, R] {
+	return Either[L, R]{left: v}
+}
+
+// Right returns an Either holding the right value v.
+func Right[L, R any](v R) Either[L, R] {
+	return Either[L, R]{right: v, isRight: true}
+}
+
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// Left returns the left value and whether the Either holds one.
+func (e Either[L, R]) Left() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// Right returns the right value and whether the Either holds one.
+func (e Either[L, R]) Right() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Optional converts the Either into an Optional of its right value,
+// dropping a left value.
+func (e Either[L, R]) Optional() Optional[R] {
+	return FromOk(e.Right())
+}
+
+// Map applies f to a right value. A left value is returned unchanged. Use
+// MapEither to change the right value's type.
+func (e Either[L, R]) Map(f func(R) R) Either[L, R] {
+	return MapEither(e, f)
+}
+
+// String implements fmt.Stringer.
+func (e Either[L, R]) String() string {
+	if e.isRight {
+		return fmt.Sprintf("Right(%v)", e.right)
+	}
+	return fmt.Sprintf("Left(%v)", e.left)
+}
+
+// MapEither applies f to the right value of e. A left value is carried
+// over.
+func MapEither[L, R, S any](e Either[L, R], f func(R) S) Either[L, S] {
+	if !e.isRight {
+		return Left[L, S](e.left)
+	}
+	return Right[L](f(e.right))
+}
+
+// FoldEither collapses e into a single value by calling onLeft or onRight,
+// depending on which side e holds.
+func FoldEither[L, R, X any](e Either[L, R], onLeft func(L) X, onRight func(R) X) X {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
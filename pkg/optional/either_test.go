@@ -0,0 +1,75 @@
+package optional
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestEitherAccessors(t *testing.T) {
+	l := Left[string, int]("missing")
+	if !l.IsLeft() || l.IsRight() {
+		t.Fatalf("Left: got IsLeft=%v IsRight=%v, want true false", l.IsLeft(), l.IsRight())
+	}
+	if v, ok := l.Left(); !ok || v != "missing" {
+		t.Fatalf("Left(): got (v=%q, ok=%v), want (\"missing\", true)", v, ok)
+	}
+	if _, ok := l.Right(); ok {
+		t.Fatalf("Right() on a Left: expected ok=false")
+	}
+
+	r := Right[string](42)
+	if !r.IsRight() || r.IsLeft() {
+		t.Fatalf("Right: got IsLeft=%v IsRight=%v, want false true", r.IsLeft(), r.IsRight())
+	}
+	if v, ok := r.Right(); !ok || v != 42 {
+		t.Fatalf("Right(): got (v=%v, ok=%v), want (42, true)", v, ok)
+	}
+
+	var zero Either[error, int]
+	if !zero.IsLeft() {
+		t.Fatalf("zero Either: expected a Left")
+	}
+}
+
+func TestEitherOptional(t *testing.T) {
+	if v, ok := Right[string](1).Optional().Get(); !ok || v != 1 {
+		t.Fatalf("Optional: got (v=%v, ok=%v), want (1, true)", v, ok)
+	}
+	if !Left[string, int]("x").Optional().IsEmpty() {
+		t.Fatalf("Optional: expected empty for a Left")
+	}
+}
+
+func TestEitherMapFold(t *testing.T) {
+	errNotFound := errors.New("not found")
+	describe := func(e Either[error, string]) string {
+		return FoldEither(e,
+			func(err error) string { return "error: " + err.Error() },
+			func(s string) string { return "value: " + s },
+		)
+	}
+
+	r := MapEither(Right[error](7), strconv.Itoa)
+	if got := describe(r); got != "value: 7" {
+		t.Fatalf("MapEither/FoldEither: got %q, want \"value: 7\"", got)
+	}
+
+	l := MapEither(Left[error, int](errNotFound), strconv.Itoa)
+	if got := describe(l); got != "error: not found" {
+		t.Fatalf("MapEither/FoldEither: got %q, want \"error: not found\"", got)
+	}
+
+	if v, _ := Right[string](2).Map(func(v int) int { return v + 1 }).Right(); v != 3 {
+		t.Fatalf("Map: got %d, want 3", v)
+	}
+}
+
+func TestEitherString(t *testing.T) {
+	if got := Right[string](1).String(); got != "Right(1)" {
+		t.Fatalf("String: got %q, want \"Right(1)\"", got)
+	}
+	if got := Left[string, int]("x").String(); got != "Left(x)" {
+		t.Fatalf("String: got %q, want \"Left(x)\"", got)
+	}
+}
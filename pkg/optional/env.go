@@ -0,0 +1,40 @@
+package optional
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+)
+
+// FromEnv sets the Optional fields of the struct v points to from the
+// environment variables named by their `env` tags:
+//
+//	type Config struct {
+//		Addr  Optional[string] `env:"APP_ADDR"`
+//		Debug Optional[bool]   `env:"APP_DEBUG"`
+//	}
+//
+// A variable that is set, even to the empty string, is parsed like
+// UnmarshalText and makes the field present. Fields whose variable is unset
+// are left as they are, so FromEnv can be combined with ApplyDefaults.
+// Nested struct fields are visited too.
+func FromEnv(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("optional: FromEnv: expected a non-nil pointer to a struct, got %T", v)
+	}
+	return walkOptionalFields(rv.Elem(), "", func(field reflect.StructField, fv reflect.Value, _ string) error {
+		name, ok := field.Tag.Lookup("env")
+		if !ok || name == "" || name == "-" {
+			return nil
+		}
+		s, ok := os.LookupEnv(name)
+		if !ok {
+			return nil
+		}
+		if err := setOptionalFromText(fv, s); err != nil {
+			return fmt.Errorf("optional: FromEnv: %s: %w", name, err)
+		}
+		return nil
+	})
+}
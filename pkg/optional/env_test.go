@@ -0,0 +1,65 @@
+package optional
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type envConfig struct {
+	Addr    Optional[string]        `env:"OPTIONAL_TEST_ADDR"`
+	Name    Optional[string]        `env:"OPTIONAL_TEST_NAME"`
+	Timeout Optional[time.Duration] `env:"OPTIONAL_TEST_TIMEOUT"`
+	Port    Optional[int]           `env:"OPTIONAL_TEST_PORT" default:"80"`
+	Ignored Optional[string]        `env:"-"`
+	DB      struct {
+		Debug Optional[bool] `env:"OPTIONAL_TEST_DB_DEBUG"`
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_ADDR", ":9090")
+	t.Setenv("OPTIONAL_TEST_NAME", "")
+	t.Setenv("OPTIONAL_TEST_TIMEOUT", "2s")
+	t.Setenv("OPTIONAL_TEST_DB_DEBUG", "true")
+
+	var cfg envConfig
+	if err := FromEnv(&cfg); err != nil {
+		t.Fatalf("FromEnv: unexpected error: %v", err)
+	}
+	if err := ApplyDefaults(&cfg); err != nil {
+		t.Fatalf("ApplyDefaults: unexpected error: %v", err)
+	}
+
+	if v, ok := cfg.Addr.Get(); !ok || v != ":9090" {
+		t.Fatalf("Addr: got (v=%q, ok=%v), want (\":9090\", true)", v, ok)
+	}
+	if v, ok := cfg.Name.Get(); !ok || v != "" {
+		t.Fatalf("Name: variable set to empty should be present, got (v=%q, ok=%v)", v, ok)
+	}
+	if v, ok := cfg.Timeout.Get(); !ok || v != 2*time.Second {
+		t.Fatalf("Timeout: got (v=%v, ok=%v), want (2s, true)", v, ok)
+	}
+	if v, ok := cfg.Port.Get(); !ok || v != 80 {
+		t.Fatalf("Port: unset variable should fall back to the default, got (v=%v, ok=%v)", v, ok)
+	}
+	if !cfg.Ignored.IsEmpty() {
+		t.Fatalf("Ignored: field tagged env:\"-\" should be skipped")
+	}
+	if v, ok := cfg.DB.Debug.Get(); !ok || !v {
+		t.Fatalf("DB.Debug: got (v=%v, ok=%v), want (true, true)", v, ok)
+	}
+}
+
+func TestFromEnvInvalid(t *testing.T) {
+	t.Setenv("OPTIONAL_TEST_PORT", "http")
+
+	var cfg envConfig
+	err := FromEnv(&cfg)
+	if err == nil || !strings.Contains(err.Error(), "OPTIONAL_TEST_PORT") {
+		t.Fatalf("FromEnv: got error %v, want it to name OPTIONAL_TEST_PORT", err)
+	}
+	if err := FromEnv(cfg); err == nil {
+		t.Fatalf("FromEnv: expected error for a non-pointer argument")
+	}
+}
@@ -0,0 +1,61 @@
+package optional
+
+import (
+	"sync"
+	"time"
+)
+
+// Expiring is an Optional whose value disappears once its time-to-live has
+// passed. The zero value is empty, uses time.Now and is safe for concurrent
+// use.
+type Expiring[T any] struct {
+	// Now returns the current time. Tests can replace it to control
+	// expiry; nil means time.Now.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	o        Optional[T]
+	deadline time.Time
+}
+
+func (e *Expiring[T]) now() time.Time {
+	if e.Now != nil {
+		return e.Now()
+	}
+	return time.Now()
+}
+
+// Set stores value until ttl has elapsed.
+func (e *Expiring[T]) Set(value T, ttl time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.o.Set(value)
+	e.deadline = e.now().Add(ttl)
+}
+
+// Unset empties the optional immediately.
+func (e *Expiring[T]) Unset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.o.Unset()
+	e.deadline = time.Time{}
+}
+
+// Get returns the value, or an empty Optional if it was never set or has
+// expired.
+func (e *Expiring[T]) Get() Optional[T] {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.o.hasValue && !e.now().Before(e.deadline) {
+		e.o.Unset()
+	}
+	return e.o
+}
+
+// Deadline returns the time at which the current value expires. It is
+// empty if no value is present.
+func (e *Expiring[T]) Deadline() Optional[time.Time] {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return Map(e.o, func(T) time.Time { return e.deadline })
+}
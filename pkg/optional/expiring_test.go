@@ -0,0 +1,45 @@
+package optional
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpiring(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := Expiring[string]{Now: func() time.Time { return now }}
+
+	if !e.Get().IsEmpty() {
+		t.Fatalf("Get: zero Expiring should be empty")
+	}
+
+	e.Set("token", time.Minute)
+	if v, ok := e.Get().Get(); !ok || v != "token" {
+		t.Fatalf("Get: got (v=%q, ok=%v), want (\"token\", true)", v, ok)
+	}
+	if d, ok := e.Deadline().Get(); !ok || !d.Equal(now.Add(time.Minute)) {
+		t.Fatalf("Deadline: got (v=%v, ok=%v), want (%v, true)", d, ok, now.Add(time.Minute))
+	}
+
+	now = now.Add(59 * time.Second)
+	if e.Get().IsEmpty() {
+		t.Fatalf("Get: value should still be present before the deadline")
+	}
+
+	now = now.Add(time.Second)
+	if !e.Get().IsEmpty() {
+		t.Fatalf("Get: value should be empty at the deadline")
+	}
+	if !e.Deadline().IsEmpty() {
+		t.Fatalf("Deadline: expected empty once the value has expired")
+	}
+}
+
+func TestExpiringUnset(t *testing.T) {
+	var e Expiring[int]
+	e.Set(1, time.Hour)
+	e.Unset()
+	if !e.Get().IsEmpty() {
+		t.Fatalf("Get: expected empty after Unset")
+	}
+}
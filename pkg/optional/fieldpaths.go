@@ -0,0 +1,98 @@
+package optional
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldPaths returns the dotted paths of the present Optional fields of
+// patch, in field order, for building update masks such as
+// google.protobuf.FieldMask (see optpb.FieldMask). patch is a struct or a
+// pointer to one; a nil pointer has no paths. FieldPaths panics for any
+// other kind.
+//
+// Path elements are taken from the name= option of a protobuf struct tag,
+// otherwise from the json tag, otherwise from the Go field name; fields
+// tagged json:"-" are skipped. *Optional fields are followed, with nil
+// counting as absent. Plain struct fields are descended into. A present
+// Optional holding a struct that itself has Optional fields is treated as
+// a nested patch and contributes the paths of its present fields instead
+// of its own.
+func FieldPaths(patch any) []string {
+	rv := reflect.ValueOf(patch)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("optional: FieldPaths: patch must be a struct, got %T", patch))
+	}
+	return appendFieldPaths(nil, rv, "")
+}
+
+func appendFieldPaths(paths []string, rv reflect.Value, prefix string) []string {
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, ok := fieldPathName(field)
+		if !ok {
+			continue
+		}
+		fv := rv.Field(i)
+		path := prefix + name
+		if v, present, isOpt := optionalValue(fv); isOpt {
+			if !present {
+				continue
+			}
+			if nested := reflect.ValueOf(v); nested.Kind() == reflect.Struct && hasOptionalFields(nested.Type()) {
+				paths = appendFieldPaths(paths, nested, path+".")
+				continue
+			}
+			paths = append(paths, path)
+		} else if field.Type.Kind() == reflect.Struct {
+			paths = appendFieldPaths(paths, fv, path+".")
+		}
+	}
+	return paths
+}
+
+// fieldPathName returns the path element for field, or false if the field
+// is excluded with json:"-".
+func fieldPathName(field reflect.StructField) (string, bool) {
+	for _, opt := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(opt, "name="); ok {
+			return name, true
+		}
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// hasOptionalFields reports whether the struct type t has an exported
+// Optional field, directly or in a nested struct.
+func hasOptionalFields(t reflect.Type) bool {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if isOptionalType(field.Type) ||
+			(field.Type.Kind() == reflect.Pointer && isOptionalType(field.Type.Elem())) ||
+			(field.Type.Kind() == reflect.Struct && hasOptionalFields(field.Type)) {
+			return true
+		}
+	}
+	return false
+}
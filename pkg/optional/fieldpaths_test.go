@@ -0,0 +1,91 @@
+package optional
+
+import (
+	"slices"
+	"testing"
+)
+
+type pathsAddress struct {
+	City Optional[string] `json:"city"`
+	Zip  Optional[string] `json:"zip"`
+}
+
+type pathsPoint struct {
+	X, Y int
+}
+
+type pathsPatch struct {
+	DisplayName Optional[string] `protobuf:"bytes,1,opt,name=display_name,json=displayName" json:"displayName"`
+	Email       Optional[string] `json:"email,omitempty"`
+	Age         Optional[int]
+	Hidden      Optional[bool]         `json:"-"`
+	Address     Optional[pathsAddress] `json:"address"`
+	Location    Optional[pathsPoint]   `json:"location"`
+	Settings    struct {
+		Theme Optional[string] `json:"theme"`
+	} `json:"settings"`
+	Version int `json:"version"`
+	secret  Optional[string]
+}
+
+func TestFieldPaths(t *testing.T) {
+	cases := []struct {
+		name  string
+		patch any
+		want  []string
+	}{
+		{name: "nil pointer", patch: (*pathsPatch)(nil), want: nil},
+		{name: "empty", patch: pathsPatch{Version: 3}, want: nil},
+		{
+			name: "all",
+			patch: func() *pathsPatch {
+				p := &pathsPatch{
+					DisplayName: New(""),
+					Email:       New("a@b.c"),
+					Age:         New(0),
+					Hidden:      New(true),
+					Address:     New(pathsAddress{Zip: New("12345")}),
+					Location:    New(pathsPoint{X: 1}),
+					secret:      New("s"),
+				}
+				p.Settings.Theme = New("dark")
+				return p
+			}(),
+			want: []string{"display_name", "email", "Age", "address.zip", "location", "settings.theme"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := FieldPaths(tc.patch); !slices.Equal(got, tc.want) {
+				t.Fatalf("FieldPaths: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFieldPathsPointerFields(t *testing.T) {
+	type patch struct {
+		A *Optional[int] `json:"a"`
+		B *Optional[int] `json:"b"`
+		C *Optional[int] `json:"c"`
+		D struct {
+			E *Optional[pathsAddress] `json:"e"`
+		} `json:"d"`
+	}
+	a, c, e := New(1), Empty[int](), New(pathsAddress{City: New("Oslo")})
+	p := patch{A: &a, C: &c}
+	p.D.E = &e
+	want := []string{"a", "d.e.city"}
+	if got := FieldPaths(p); !slices.Equal(got, want) {
+		t.Fatalf("FieldPaths: got %q, want %q", got, want)
+	}
+}
+
+func TestFieldPathsPanicsOnNonStruct(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("FieldPaths: expected panic for a non-struct")
+		}
+	}()
+	FieldPaths(42)
+}
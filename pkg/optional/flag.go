@@ -0,0 +1,45 @@
+package optional
+
+import (
+	"reflect"
+
+	"github.com/Palladium-blockchain/go-optional/internal/textconv"
+)
+
+// Flag is a flag.Value holding an Optional, so a flag that is never passed
+// stays empty while one passed as the zero value becomes present.
+// Values are parsed the same way as UnmarshalText.
+//
+//	var port optional.Flag[int]
+//	flag.Var(&port, "port", "listen port")
+type Flag[T any] struct {
+	Optional[T]
+}
+
+// String implements flag.Value.
+// Empty flags render as an empty string.
+func (f *Flag[T]) String() string {
+	if f == nil || !f.hasValue {
+		return ""
+	}
+	text, err := textconv.Format(f.value)
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+// Set implements flag.Value by parsing s into T.
+func (f *Flag[T]) Set(s string) error {
+	var v T
+	if err := textconv.Parse(&v, s); err != nil {
+		return err
+	}
+	f.Optional.Set(v)
+	return nil
+}
+
+// IsBoolFlag lets boolean flags be passed without a value, as in -verbose.
+func (f *Flag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
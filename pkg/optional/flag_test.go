@@ -0,0 +1,82 @@
+package optional
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+var _ flag.Value = (*Flag[int])(nil)
+
+func newTestFlagSet() (*flag.FlagSet, *Flag[int], *Flag[string], *Flag[bool], *Flag[time.Duration]) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var (
+		port    Flag[int]
+		name    Flag[string]
+		verbose Flag[bool]
+		timeout Flag[time.Duration]
+	)
+	fs.Var(&port, "port", "listen port")
+	fs.Var(&name, "name", "name")
+	fs.Var(&verbose, "verbose", "verbose output")
+	fs.Var(&timeout, "timeout", "timeout")
+	return fs, &port, &name, &verbose, &timeout
+}
+
+func TestFlagUnspecifiedIsEmpty(t *testing.T) {
+	fs, port, name, verbose, timeout := newTestFlagSet()
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if !port.IsEmpty() || !name.IsEmpty() || !verbose.IsEmpty() || !timeout.IsEmpty() {
+		t.Fatalf("flags that were not passed should be empty")
+	}
+}
+
+func TestFlagPassedValues(t *testing.T) {
+	fs, port, name, verbose, timeout := newTestFlagSet()
+	if err := fs.Parse([]string{"-port=0", "-name", "", "-verbose", "-timeout=2s"}); err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+
+	if v, ok := port.Get(); !ok || v != 0 {
+		t.Fatalf("port: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+	if v, ok := name.Get(); !ok || v != "" {
+		t.Fatalf("name: got (v=%q, ok=%v), want (\"\", true)", v, ok)
+	}
+	if v, ok := verbose.Get(); !ok || !v {
+		t.Fatalf("verbose: got (v=%v, ok=%v), want (true, true)", v, ok)
+	}
+	if v, ok := timeout.Get(); !ok || v != 2*time.Second {
+		t.Fatalf("timeout: got (v=%v, ok=%v), want (2s, true)", v, ok)
+	}
+	if got := timeout.String(); got != "2s" {
+		t.Fatalf("String: got %q, want \"2s\"", got)
+	}
+}
+
+func TestFlagInvalidValue(t *testing.T) {
+	fs, port, _, _, _ := newTestFlagSet()
+	if err := fs.Parse([]string{"-port=abc"}); err == nil {
+		t.Fatalf("Parse: expected error for invalid value")
+	}
+	if !port.IsEmpty() {
+		t.Fatalf("port should stay empty after a parse error")
+	}
+}
+
+func TestFlagDefaultsOmitEmptyFlags(t *testing.T) {
+	fs, _, _, _, _ := newTestFlagSet()
+	var out strings.Builder
+	fs.SetOutput(&out)
+	fs.PrintDefaults()
+
+	if strings.Contains(out.String(), "default") {
+		t.Fatalf("empty flags should not print a default value:\n%s", out.String())
+	}
+}
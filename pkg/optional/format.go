@@ -0,0 +1,70 @@
+package optional
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+)
+
+// String implements fmt.Stringer.
+// Empty optionals render as "None", present ones as "Some(<value>)".
+func (o Optional[T]) String() string {
+	if !o.hasValue {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// GoString implements fmt.GoStringer.
+// It renders Go syntax such as optional.New(42) or optional.Empty[int]().
+func (o Optional[T]) GoString() string {
+	typ := reflect.TypeFor[T]()
+	if !o.hasValue {
+		return "optional.Empty[" + typ.String() + "]()"
+	}
+	if infersType(typ) {
+		return fmt.Sprintf("optional.New(%#v)", o.value)
+	}
+	return fmt.Sprintf("optional.New[%s](%#v)", typ, o.value)
+}
+
+// infersType reports whether the %#v form of a value of type t makes
+// the compiler infer exactly t, so explicit type arguments can be omitted.
+func infersType(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeFor[int](), reflect.TypeFor[string](), reflect.TypeFor[bool]():
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Map, reflect.Array:
+		return true
+	}
+	return false
+}
+
+// Format implements fmt.Formatter.
+// The %v and %s verbs render like String, with flags, width and precision
+// applied to the contained value; %#v renders like GoString. Any other verb
+// formats the contained value directly. Empty optionals render as "None".
+func (o Optional[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = io.WriteString(f, o.GoString())
+	case !o.hasValue:
+		_, _ = io.WriteString(f, "None")
+	case verb == 'v' || verb == 's':
+		_, _ = fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", o.value)
+	default:
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), o.value)
+	}
+}
+
+// LogValue implements slog.LogValuer.
+// Empty optionals log as a nil value, present ones as the contained value.
+func (o Optional[T]) LogValue() slog.Value {
+	if !o.hasValue {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.value)
+}
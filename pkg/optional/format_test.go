@@ -0,0 +1,118 @@
+package optional
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		name string
+		in   fmt.Stringer
+		want string
+	}{
+		{name: "empty", in: Empty[int](), want: "None"},
+		{name: "int", in: New(42), want: "Some(42)"},
+		{name: "zero value", in: New(""), want: "Some()"},
+		{name: "struct", in: New(struct{ A int }{A: 1}), want: "Some({1})"},
+		{name: "nested", in: New(New(1)), want: "Some(Some(1))"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.in.String(); got != tc.want {
+				t.Fatalf("String: got %q, want %q", got, tc.want)
+			}
+			if got := fmt.Sprintf("%v", tc.in); got != tc.want {
+				t.Fatalf("%%v: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+type goStringPoint struct {
+	X, Y int
+}
+
+func TestGoString(t *testing.T) {
+	cases := []struct {
+		name string
+		in   fmt.GoStringer
+		want string
+	}{
+		{name: "empty int", in: Empty[int](), want: "optional.Empty[int]()"},
+		{name: "int", in: New(42), want: "optional.New(42)"},
+		{name: "string", in: New("a"), want: `optional.New("a")`},
+		{name: "int64 keeps type", in: New[int64](7), want: "optional.New[int64](7)"},
+		{name: "float keeps type", in: New(1.0), want: "optional.New[float64](1)"},
+		{name: "slice", in: New([]int{1}), want: "optional.New([]int{1})"},
+		{name: "named struct", in: New(goStringPoint{X: 1, Y: 2}), want: "optional.New(optional.goStringPoint{X:1, Y:2})"},
+		{name: "empty struct type", in: Empty[goStringPoint](), want: "optional.Empty[optional.goStringPoint]()"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.in.GoString(); got != tc.want {
+				t.Fatalf("GoString: got %q, want %q", got, tc.want)
+			}
+			if got := fmt.Sprintf("%#v", tc.in); got != tc.want {
+				t.Fatalf("%%#v: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		name   string
+		format string
+		in     any
+		want   string
+	}{
+		{name: "v present", format: "%v", in: New(42), want: "Some(42)"},
+		{name: "v empty", format: "%v", in: Empty[int](), want: "None"},
+		{name: "s present", format: "%s", in: New("a"), want: "Some(a)"},
+		{name: "plus v applies to value", format: "%+v", in: New(goStringPoint{X: 1}), want: "Some({X:1 Y:0})"},
+		{name: "width applies to value", format: "%4v", in: New(7), want: "Some(   7)"},
+		{name: "sharp v", format: "%#v", in: New(42), want: "optional.New(42)"},
+		{name: "sharp v empty", format: "%#v", in: Empty[string](), want: "optional.Empty[string]()"},
+		{name: "q", format: "%q", in: New("a b"), want: `"a b"`},
+		{name: "x", format: "%x", in: New(255), want: "ff"},
+		{name: "precision", format: "%.2f", in: New(3.14159), want: "3.14"},
+		{name: "d empty", format: "%d", in: Empty[int](), want: "None"},
+		{name: "nested", format: "%v", in: New(New(1)), want: "Some(Some(1))"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tc.format, tc.in); got != tc.want {
+				t.Fatalf("Sprintf(%q): got %q, want %q", tc.format, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("m",
+		"empty", Empty[int](),
+		"int", New(42),
+		"struct", New(goStringPoint{X: 1, Y: 2}),
+	)
+
+	want := `{"msg":"m","empty":null,"int":42,"struct":{"X":1,"Y":2}}` + "\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("log output: got %q, want %q", got, want)
+	}
+}
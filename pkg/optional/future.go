@@ -0,0 +1,59 @@
+package optional
+
+import (
+	"context"
+	"sync"
+)
+
+// Future is an Optional that is published once by one goroutine and
+// awaited by others.
+type Future[T any] struct {
+	once sync.Once
+	done chan struct{}
+	o    Optional[T]
+	err  error
+}
+
+// NewFuture returns an unresolved Future.
+func NewFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+// Resolve completes the future with value. It reports false if the future
+// was already resolved or rejected.
+func (f *Future[T]) Resolve(value T) bool {
+	return f.complete(New(value), nil)
+}
+
+// Reject completes the future with err and no value. It reports false if
+// the future was already resolved or rejected.
+func (f *Future[T]) Reject(err error) bool {
+	return f.complete(Empty[T](), err)
+}
+
+func (f *Future[T]) complete(o Optional[T], err error) bool {
+	ok := false
+	f.once.Do(func() {
+		f.o, f.err = o, err
+		close(f.done)
+		ok = true
+	})
+	return ok
+}
+
+// Done returns a channel that is closed once the future completes.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await blocks until the future completes or ctx is done. It returns the
+// resolved value, or an empty Optional with the rejection error or
+// ctx.Err().
+func (f *Future[T]) Await(ctx context.Context) (Optional[T], error) {
+	select {
+	case <-f.done:
+		return f.o, f.err
+	case <-ctx.Done():
+		return Empty[T](), ctx.Err()
+	}
+}
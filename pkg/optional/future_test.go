@@ -0,0 +1,64 @@
+package optional
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFutureResolve(t *testing.T) {
+	f := NewFuture[int]()
+	go f.Resolve(7)
+
+	got, err := f.Await(context.Background())
+	if err != nil {
+		t.Fatalf("Await: unexpected error: %v", err)
+	}
+	if v, ok := got.Get(); !ok || v != 7 {
+		t.Fatalf("Await: got (v=%v, ok=%v), want (7, true)", v, ok)
+	}
+
+	if f.Resolve(8) || f.Reject(errors.New("late")) {
+		t.Fatalf("Resolve/Reject: should report false once the future is complete")
+	}
+	if got, _ := f.Await(context.Background()); !Equal(got, New(7)) {
+		t.Fatalf("Await: value changed after completion: %v", got)
+	}
+}
+
+func TestFutureReject(t *testing.T) {
+	errFailed := errors.New("failed")
+	f := NewFuture[string]()
+	if !f.Reject(errFailed) {
+		t.Fatalf("Reject: should report true on first completion")
+	}
+
+	select {
+	case <-f.Done():
+	default:
+		t.Fatalf("Done: channel should be closed after Reject")
+	}
+
+	got, err := f.Await(context.Background())
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("Await: got error %v, want %v", err, errFailed)
+	}
+	if !got.IsEmpty() {
+		t.Fatalf("Await: expected empty Optional after Reject")
+	}
+}
+
+func TestFutureAwaitCanceled(t *testing.T) {
+	f := NewFuture[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	got, err := f.Await(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Await: got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !got.IsEmpty() {
+		t.Fatalf("Await: expected empty Optional when ctx is done")
+	}
+}
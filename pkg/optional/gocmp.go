@@ -0,0 +1,67 @@
+package optional
+
+import "github.com/google/go-cmp/cmp"
+
+// cmpValuer is implemented by Optional, TriState and Ref. cmpValue returns
+// the value go-cmp compares and renders in place of the receiver.
+type cmpValuer interface {
+	cmpValue() any
+}
+
+// The types cmpValue returns; go-cmp renders them as optional.some{Some: v},
+// optional.none{}, optional.undefined{} and optional.null{}.
+type (
+	some      struct{ Some any }
+	none      struct{}
+	undefined struct{}
+	null      struct{}
+)
+
+func (o Optional[T]) cmpValue() any {
+	if !o.hasValue {
+		return none{}
+	}
+	return some{o.value}
+}
+
+func (s TriState[T]) cmpValue() any {
+	switch s.state {
+	case stateUndefined:
+		return undefined{}
+	case stateNull:
+		return null{}
+	}
+	return some{s.value}
+}
+
+func (r Ref[T]) cmpValue() any {
+	if r.p == nil {
+		return none{}
+	}
+	return some{r.p}
+}
+
+// CmpOptions returns options that let github.com/google/go-cmp/cmp compare
+// Optional, TriState and Ref values, which it otherwise rejects because of
+// their unexported fields:
+//
+//	cmp.Diff(want, got, optional.CmpOptions())
+//
+// Two empty values in the same state are equal. Otherwise the values are
+// transformed so cmp.Diff renders them as optional.some{Some: v},
+// optional.none{}, optional.undefined{} or optional.null{}, and held values
+// are compared with the other options passed to cmp, so options such as
+// cmpopts.EquateApprox apply to them.
+func CmpOptions() cmp.Options {
+	return cmp.Options{
+		cmp.FilterValues(bothEmpty, cmp.Comparer(func(x, y cmpValuer) bool { return true })),
+		cmp.FilterValues(func(x, y cmpValuer) bool { return !bothEmpty(x, y) },
+			cmp.Transformer("optional", cmpValuer.cmpValue)),
+	}
+}
+
+func bothEmpty(x, y cmpValuer) bool {
+	vx, vy := x.cmpValue(), y.cmpValue()
+	_, isSome := vx.(some)
+	return !isSome && vx == vy
+}
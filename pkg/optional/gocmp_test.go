@@ -0,0 +1,94 @@
+package optional
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type cmpProfile struct {
+	Name   Optional[string]
+	Tags   Optional[[]string]
+	Score  Optional[float64]
+	Email  TriState[string]
+	Parent Ref[int]
+}
+
+func TestCmpOptionsEqual(t *testing.T) {
+	one, also := 1, 1
+	cases := []struct {
+		name string
+		x, y cmpProfile
+	}{
+		{name: "zero", x: cmpProfile{}, y: cmpProfile{}},
+		{
+			name: "present",
+			x:    cmpProfile{Name: New("a"), Tags: New([]string{"x"}), Email: Value("e"), Parent: NewRef(&one)},
+			y:    cmpProfile{Name: New("a"), Tags: New([]string{"x"}), Email: Value("e"), Parent: NewRef(&also)},
+		},
+		{name: "null", x: cmpProfile{Email: Null[string]()}, y: cmpProfile{Email: Null[string]()}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := cmp.Diff(tc.x, tc.y, CmpOptions()); d != "" {
+				t.Fatalf("Diff: got %s, want no difference", d)
+			}
+		})
+	}
+}
+
+func TestCmpOptionsDiff(t *testing.T) {
+	cases := []struct {
+		name string
+		x, y cmpProfile
+		want []string
+	}{
+		{
+			name: "value",
+			x:    cmpProfile{Name: New("a")},
+			y:    cmpProfile{Name: New("b")},
+			want: []string{`-`, `Some: string("a")`, `+`, `Some: string("b")`},
+		},
+		{
+			name: "empty",
+			x:    cmpProfile{Name: New("a")},
+			y:    cmpProfile{},
+			want: []string{`optional.some{Some: string("a")}`, `optional.none{}`},
+		},
+		{
+			name: "tristate",
+			x:    cmpProfile{Email: Null[string]()},
+			y:    cmpProfile{},
+			want: []string{`optional.null{}`, `optional.undefined{}`},
+		},
+		{
+			name: "nested",
+			x:    cmpProfile{Tags: New([]string{"x", "y"})},
+			y:    cmpProfile{Tags: New([]string{"x", "z"})},
+			want: []string{`-`, `"y"`, `+`, `"z"`},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := cmp.Diff(tc.x, tc.y, CmpOptions())
+			for _, want := range tc.want {
+				if !strings.Contains(d, want) {
+					t.Fatalf("Diff: got\n%s\nwant it to contain %q", d, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCmpOptionsNestedOptions(t *testing.T) {
+	x := cmpProfile{Score: New(1.0)}
+	y := cmpProfile{Score: New(1.0 + 1e-12)}
+	if cmp.Equal(x, y, CmpOptions()) {
+		t.Fatalf("Equal: got true without EquateApprox, want false")
+	}
+	if !cmp.Equal(x, y, CmpOptions(), cmpopts.EquateApprox(0, 1e-9)) {
+		t.Fatalf("Equal: got false with EquateApprox, want true")
+	}
+}
@@ -0,0 +1,104 @@
+package optional
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// gqlMarshaler and gqlUnmarshaler mirror graphql.Marshaler and
+// graphql.Unmarshaler of github.com/99designs/gqlgen, so values of T that
+// are gqlgen scalars themselves are encoded with their own methods.
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+type gqlUnmarshaler interface {
+	UnmarshalGQL(v any) error
+}
+
+// MarshalGQL implements the graphql.Marshaler interface of gqlgen, so an
+// Optional can be bound to a nullable GraphQL type. Empty optionals are
+// written as null; present values use T's MarshalGQL if it has one and
+// JSON otherwise. A value that cannot be encoded is also written as null,
+// since the interface has no way to report errors.
+func (o Optional[T]) MarshalGQL(w io.Writer) {
+	if !o.hasValue {
+		_, _ = io.WriteString(w, "null")
+		return
+	}
+	writeGQL(w, o.value)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen.
+// A null input unsets the optional; anything else is converted to T.
+func (o *Optional[T]) UnmarshalGQL(v any) error {
+	if v == nil {
+		o.Unset()
+		return nil
+	}
+	value, err := decodeGQL[T](v)
+	if err != nil {
+		return err
+	}
+	o.Set(value)
+	return nil
+}
+
+// MarshalGQL implements the graphql.Marshaler interface of gqlgen.
+// Null and Undefined are both written as null.
+func (s TriState[T]) MarshalGQL(w io.Writer) {
+	if s.state != stateValue {
+		_, _ = io.WriteString(w, "null")
+		return
+	}
+	writeGQL(w, s.value)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen.
+// gqlgen only calls it for input fields that are present, so omitted
+// fields stay Undefined, null becomes Null and anything else a Value.
+func (s *TriState[T]) UnmarshalGQL(v any) error {
+	if v == nil {
+		*s = Null[T]()
+		return nil
+	}
+	value, err := decodeGQL[T](v)
+	if err != nil {
+		return err
+	}
+	*s = Value(value)
+	return nil
+}
+
+func writeGQL(w io.Writer, v any) {
+	if m, ok := v.(gqlMarshaler); ok {
+		m.MarshalGQL(w)
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		_, _ = io.WriteString(w, "null")
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// decodeGQL converts a gqlgen input value, as decoded from the request
+// (string, bool, json.Number, int64, float64, []any or map[string]any),
+// into T.
+func decodeGQL[T any](v any) (T, error) {
+	var out T
+	if u, ok := any(&out).(gqlUnmarshaler); ok {
+		err := u.UnmarshalGQL(v)
+		return out, err
+	}
+	if t, ok := v.(T); ok {
+		return t, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return out, err
+	}
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
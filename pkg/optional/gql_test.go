@@ -0,0 +1,125 @@
+package optional
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// gqlValue mirrors the graphql.Marshaler and graphql.Unmarshaler
+// interfaces of gqlgen.
+type gqlValue interface {
+	MarshalGQL(w io.Writer)
+	UnmarshalGQL(v any) error
+}
+
+var (
+	_ gqlValue = (*Optional[int])(nil)
+	_ gqlValue = (*TriState[int])(nil)
+)
+
+// gqlUpper is a custom scalar that upper-cases its input.
+type gqlUpper string
+
+func (u gqlUpper) MarshalGQL(w io.Writer) {
+	io.WriteString(w, `"`+strings.ToUpper(string(u))+`"`)
+}
+
+func (u *gqlUpper) UnmarshalGQL(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return errors.New("gqlUpper must be a string")
+	}
+	*u = gqlUpper(strings.ToUpper(s))
+	return nil
+}
+
+func marshalGQL(m interface{ MarshalGQL(io.Writer) }) string {
+	var b strings.Builder
+	m.MarshalGQL(&b)
+	return b.String()
+}
+
+func TestMarshalGQL(t *testing.T) {
+	cases := []struct {
+		name string
+		in   interface{ MarshalGQL(io.Writer) }
+		want string
+	}{
+		{name: "empty", in: Empty[int](), want: "null"},
+		{name: "int", in: New(3), want: "3"},
+		{name: "string", in: New("a\"b"), want: `"a\"b"`},
+		{name: "struct", in: New(struct{ A int }{A: 1}), want: `{"A":1}`},
+		{name: "custom scalar", in: New(gqlUpper("abc")), want: `"ABC"`},
+		{name: "unencodable", in: New(func() {}), want: "null"},
+		{name: "tristate undefined", in: Undefined[int](), want: "null"},
+		{name: "tristate null", in: Null[int](), want: "null"},
+		{name: "tristate value", in: Value(true), want: "true"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := marshalGQL(tc.in); got != tc.want {
+				t.Fatalf("MarshalGQL: got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalGQL(t *testing.T) {
+	o := New(1)
+	if err := o.UnmarshalGQL(nil); err != nil || !o.IsEmpty() {
+		t.Fatalf("UnmarshalGQL(nil): got (%v, %v), want empty Optional", o, err)
+	}
+
+	var n Optional[int]
+	if err := n.UnmarshalGQL(json.Number("42")); err != nil {
+		t.Fatalf("UnmarshalGQL: unexpected error: %v", err)
+	}
+	if v, ok := n.Get(); !ok || v != 42 {
+		t.Fatalf("UnmarshalGQL: got (v=%v, ok=%v), want (42, true)", v, ok)
+	}
+
+	type input struct {
+		Name string `json:"name"`
+	}
+	var in Optional[input]
+	if err := in.UnmarshalGQL(map[string]any{"name": "x"}); err != nil {
+		t.Fatalf("UnmarshalGQL: unexpected error: %v", err)
+	}
+	if v, ok := in.Get(); !ok || v.Name != "x" {
+		t.Fatalf("UnmarshalGQL: got (v=%+v, ok=%v), want ({Name:x}, true)", v, ok)
+	}
+
+	var u Optional[gqlUpper]
+	if err := u.UnmarshalGQL("abc"); err != nil {
+		t.Fatalf("UnmarshalGQL: unexpected error: %v", err)
+	}
+	if v, ok := u.Get(); !ok || v != "ABC" {
+		t.Fatalf("UnmarshalGQL: got (v=%q, ok=%v), want (\"ABC\", true)", v, ok)
+	}
+	if err := u.UnmarshalGQL(1); err == nil {
+		t.Fatalf("UnmarshalGQL: expected error from the custom scalar")
+	}
+
+	if err := n.UnmarshalGQL("nope"); err == nil {
+		t.Fatalf("UnmarshalGQL: expected error for a string into int")
+	}
+}
+
+func TestTriStateUnmarshalGQL(t *testing.T) {
+	var s TriState[string]
+	if !s.IsUndefined() {
+		t.Fatalf("zero TriState: expected Undefined")
+	}
+	if err := s.UnmarshalGQL(nil); err != nil || !s.IsNull() {
+		t.Fatalf("UnmarshalGQL(nil): got (%v, %v), want Null", s, err)
+	}
+	if err := s.UnmarshalGQL("v"); err != nil {
+		t.Fatalf("UnmarshalGQL: unexpected error: %v", err)
+	}
+	if v, ok := s.Get(); !ok || v != "v" {
+		t.Fatalf("UnmarshalGQL: got (v=%q, ok=%v), want (\"v\", true)", v, ok)
+	}
+}
@@ -0,0 +1,28 @@
+package optional
+
+import "hash/maphash"
+
+// Hash returns a hash of o for use with hash/maphash, for example to pick
+// the shard of a cache keyed by Optionals. An empty Optional is hashed as
+// the single byte 0, a present one as the byte 1 followed by the value as
+// written by maphash.WriteComparable, so an empty Optional and New of the
+// zero value hash as different inputs. As with maphash, hashes are
+// only stable for a given seed within one process.
+func Hash[T comparable](seed maphash.Seed, o Optional[T]) uint64 {
+	return HashFunc(seed, o, maphash.WriteComparable[T])
+}
+
+// HashFunc is like Hash for values that are not comparable or that need
+// their own notion of equality: hash writes the value of a present
+// Optional to h. It is not called for an empty Optional.
+func HashFunc[T any](seed maphash.Seed, o Optional[T], hash func(h *maphash.Hash, v T)) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	if !o.hasValue {
+		h.WriteByte(0)
+		return h.Sum64()
+	}
+	h.WriteByte(1)
+	hash(&h, o.value)
+	return h.Sum64()
+}
@@ -0,0 +1,56 @@
+package optional
+
+import (
+	"hash/maphash"
+	"strings"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	if Hash(seed, New("a")) != Hash(seed, New("a")) {
+		t.Fatalf("Hash: equal Optionals hash differently")
+	}
+	if Hash(seed, Empty[string]()) != Hash(seed, Empty[string]()) {
+		t.Fatalf("Hash: empty Optionals hash differently")
+	}
+	if Hash(seed, Empty[int]()) == Hash(seed, New(0)) {
+		t.Fatalf("Hash: empty Optional hashes like New(0)")
+	}
+	if Hash(seed, New("a")) == Hash(seed, New("b")) {
+		t.Fatalf("Hash: different values hash the same")
+	}
+
+	var empty maphash.Hash
+	empty.SetSeed(seed)
+	empty.WriteByte(0)
+	if got, want := Hash(seed, Empty[int]()), empty.Sum64(); got != want {
+		t.Fatalf("Hash of empty: got %d, want %d", got, want)
+	}
+}
+
+func TestHashFunc(t *testing.T) {
+	seed := maphash.MakeSeed()
+	foldCase := func(h *maphash.Hash, v []string) {
+		for _, s := range v {
+			h.WriteString(strings.ToLower(s))
+			h.WriteByte(0)
+		}
+	}
+
+	a := HashFunc(seed, New([]string{"A", "b"}), foldCase)
+	b := HashFunc(seed, New([]string{"a", "B"}), foldCase)
+	if a != b {
+		t.Fatalf("HashFunc: got %d and %d, want equal hashes", a, b)
+	}
+
+	called := false
+	e := HashFunc(seed, Empty[[]string](), func(*maphash.Hash, []string) { called = true })
+	if called {
+		t.Fatalf("HashFunc: hash called for an empty Optional")
+	}
+	if e != Hash(seed, Empty[int]()) {
+		t.Fatalf("HashFunc of empty: got %d, want the same hash as Hash", e)
+	}
+}
@@ -0,0 +1,22 @@
+package optional
+
+import "iter"
+
+// Values returns an iterator that yields the value if one is present, and
+// nothing otherwise.
+func (o Optional[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.hasValue {
+			yield(o.value)
+		}
+	}
+}
+
+// FromSeq returns the first value yielded by seq, or an empty Optional if
+// seq yields nothing. Iteration stops after the first value.
+func FromSeq[T any](seq iter.Seq[T]) Optional[T] {
+	for v := range seq {
+		return New(v)
+	}
+	return Optional[T]{}
+}
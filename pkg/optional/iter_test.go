@@ -0,0 +1,42 @@
+package optional
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	if got := slices.Collect(New(3).Values()); !slices.Equal(got, []int{3}) {
+		t.Fatalf("Values: got %v, want [3]", got)
+	}
+	if got := slices.Collect(Empty[int]().Values()); len(got) != 0 {
+		t.Fatalf("Values on empty: got %v, want []", got)
+	}
+
+	for range New(1).Values() {
+		break
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	pulled := 0
+	seq := func(yield func(int) bool) {
+		for i := 1; i <= 3; i++ {
+			pulled++
+			if !yield(i * 10) {
+				return
+			}
+		}
+	}
+
+	if v, ok := FromSeq(seq).Get(); !ok || v != 10 {
+		t.Fatalf("FromSeq: got (v=%v, ok=%v), want (10, true)", v, ok)
+	}
+	if pulled != 1 {
+		t.Fatalf("FromSeq: pulled %d values, want 1", pulled)
+	}
+
+	if !FromSeq(slices.Values([]int(nil))).IsEmpty() {
+		t.Fatalf("FromSeq: expected empty for an empty sequence")
+	}
+}
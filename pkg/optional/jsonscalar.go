@@ -0,0 +1,283 @@
+package optional
+
+import (
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// The functions in this file encode and decode Optionals of predeclared
+// string, bool, integer and float64 types without going through
+// encoding/json's reflection. They produce exactly what encoding/json would,
+// and report false for anything they do not handle so the caller can fall
+// back to it, including every input that encoding/json would reject.
+
+// appendJSONScalar appends the JSON encoding of v if v has a predeclared
+// string, bool, integer or float64 type.
+func appendJSONScalar(dst []byte, v any) ([]byte, bool) {
+	switch v := v.(type) {
+	case string:
+		if !utf8.ValidString(v) {
+			// Replacing invalid bytes differs between Go releases.
+			return dst, false
+		}
+		return appendJSONString(dst, v), true
+	case bool:
+		return strconv.AppendBool(dst, v), true
+	case int:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int8:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int16:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int32:
+		return strconv.AppendInt(dst, int64(v), 10), true
+	case int64:
+		return strconv.AppendInt(dst, v, 10), true
+	case uint:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint8:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint16:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint32:
+		return strconv.AppendUint(dst, uint64(v), 10), true
+	case uint64:
+		return strconv.AppendUint(dst, v, 10), true
+	case float64:
+		return appendJSONFloat(dst, v)
+	}
+	return dst, false
+}
+
+// appendJSONFloat formats f like encoding/json. NaN and infinities are left
+// to encoding/json, which reports them as errors.
+func appendJSONFloat(dst []byte, f float64) ([]byte, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return dst, false
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	dst = strconv.AppendFloat(dst, f, format, -1, 64)
+	if format == 'e' {
+		// Shorten e-09 to e-9, as encoding/json does.
+		if n := len(dst); n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst, true
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString quotes s like encoding/json with HTML escaping enabled.
+// s must be valid UTF-8.
+func appendJSONString(dst []byte, s string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// unmarshalJSONScalar decodes data into o if T is a predeclared string,
+// bool, integer or float64 type and data is a plain literal of that type.
+// Strings with escape sequences are left to encoding/json.
+func (o *Optional[T]) unmarshalJSONScalar(data []byte) bool {
+	switch p := any(&o.value).(type) {
+	case *string:
+		s, ok := parseJSONString(data)
+		if !ok {
+			return false
+		}
+		*p = s
+	case *bool:
+		switch string(data) {
+		case "true":
+			*p = true
+		case "false":
+			*p = false
+		default:
+			return false
+		}
+	case *int:
+		return setJSONInt(o, p, data, strconv.IntSize)
+	case *int8:
+		return setJSONInt(o, p, data, 8)
+	case *int16:
+		return setJSONInt(o, p, data, 16)
+	case *int32:
+		return setJSONInt(o, p, data, 32)
+	case *int64:
+		return setJSONInt(o, p, data, 64)
+	case *uint:
+		return setJSONUint(o, p, data, strconv.IntSize)
+	case *uint8:
+		return setJSONUint(o, p, data, 8)
+	case *uint16:
+		return setJSONUint(o, p, data, 16)
+	case *uint32:
+		return setJSONUint(o, p, data, 32)
+	case *uint64:
+		return setJSONUint(o, p, data, 64)
+	case *float64:
+		if !isJSONNumber(data) {
+			return false
+		}
+		f, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return false
+		}
+		*p = f
+	default:
+		return false
+	}
+	o.hasValue = true
+	return true
+}
+
+func setJSONInt[T any, I int | int8 | int16 | int32 | int64](o *Optional[T], p *I, data []byte, bits int) bool {
+	if !isJSONInteger(data) {
+		return false
+	}
+	n, err := strconv.ParseInt(string(data), 10, bits)
+	if err != nil {
+		return false
+	}
+	*p = I(n)
+	o.hasValue = true
+	return true
+}
+
+func setJSONUint[T any, U uint | uint8 | uint16 | uint32 | uint64](o *Optional[T], p *U, data []byte, bits int) bool {
+	if !isJSONInteger(data) || data[0] == '-' {
+		return false
+	}
+	n, err := strconv.ParseUint(string(data), 10, bits)
+	if err != nil {
+		return false
+	}
+	*p = U(n)
+	o.hasValue = true
+	return true
+}
+
+// parseJSONString returns the contents of a JSON string literal that
+// contains no escape sequences, control characters or invalid UTF-8.
+func parseJSONString(data []byte) (string, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return "", false
+	}
+	inner := data[1 : len(data)-1]
+	for _, c := range inner {
+		if c < 0x20 || c == '"' || c == '\\' {
+			return "", false
+		}
+	}
+	if !utf8.Valid(inner) {
+		return "", false
+	}
+	return string(inner), true
+}
+
+// isJSONInteger reports whether data is a JSON number without a fraction
+// or exponent.
+func isJSONInteger(data []byte) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	return scanJSONDigits(data, i) == len(data)
+}
+
+// isJSONNumber reports whether data matches the JSON number grammar.
+func isJSONNumber(data []byte) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	if i = scanJSONDigits(data, i); i < 0 {
+		return false
+	}
+	if i < len(data) && data[i] == '.' {
+		start := i + 1
+		for i = start; i < len(data) && isDigit(data[i]); i++ {
+		}
+		if i == start {
+			return false
+		}
+	}
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		start := i
+		for ; i < len(data) && isDigit(data[i]); i++ {
+		}
+		if i == start {
+			return false
+		}
+	}
+	return i == len(data)
+}
+
+// scanJSONDigits scans the integer part of a JSON number starting at i: a
+// single 0, or a non-zero digit followed by digits. It returns the index
+// after it, or -1 if there is none.
+func scanJSONDigits(data []byte, i int) int {
+	switch {
+	case i >= len(data):
+		return -1
+	case data[i] == '0':
+		return i + 1
+	case isDigit(data[i]):
+		for i++; i < len(data) && isDigit(data[i]); i++ {
+		}
+		return i
+	}
+	return -1
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,126 @@
+package optional
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestAppendJSONScalarMatchesEncodingJSON(t *testing.T) {
+	values := []any{
+		"", "plain", "quote\" backslash\\", "tab\tnewline\n\r\b\f", "\x00\x1f\x7f",
+		"<a href='x'>&</a>", "line\u2028sep\u2029", "日本語",
+		true, false,
+		0, -1, math.MaxInt64, int8(math.MinInt8), int16(-300), int32(1 << 30), int64(math.MinInt64),
+		uint(7), uint8(255), uint16(65535), uint32(math.MaxUint32), uint64(math.MaxUint64),
+		0.0, math.Copysign(0, -1), 1.5, -2.25, 1e20, 1e21, 1e-6, 1e-7, 123456789.125,
+		math.MaxFloat64, math.SmallestNonzeroFloat64, 3e-9, -4.5e-10,
+	}
+	for _, v := range values {
+		want, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("json.Marshal(%#v): unexpected error: %v", v, err)
+		}
+		got, ok := appendJSONScalar(nil, v)
+		if !ok {
+			t.Fatalf("appendJSONScalar(%#v): not handled", v)
+		}
+		if string(got) != string(want) {
+			t.Fatalf("appendJSONScalar(%#v): got %s, want %s", v, got, want)
+		}
+	}
+}
+
+func TestMarshalJSONNonFinite(t *testing.T) {
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := New(f).MarshalJSON(); err == nil {
+			t.Fatalf("MarshalJSON(%v): expected error", f)
+		}
+	}
+}
+
+func TestMarshalJSONInvalidUTF8(t *testing.T) {
+	const s = "bad\xffutf8"
+	want, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	got, err := New(s).MarshalJSON()
+	if err != nil || string(got) != string(want) {
+		t.Fatalf("MarshalJSON: got (%s, %v), want (%s, nil)", got, err, want)
+	}
+}
+
+// checkUnmarshalMatches decodes data both through Optional[T] and straight
+// into a T with encoding/json, and fails if they disagree.
+func checkUnmarshalMatches[T comparable](t *testing.T, data string) {
+	t.Helper()
+
+	var want T
+	wantErr := json.Unmarshal([]byte(data), &want)
+
+	var got Optional[T]
+	gotErr := got.UnmarshalJSON([]byte(data))
+
+	if (gotErr != nil) != (wantErr != nil) {
+		t.Fatalf("UnmarshalJSON[%T](%s): got error %v, want %v", want, data, gotErr, wantErr)
+	}
+	if wantErr != nil {
+		return
+	}
+	if v, ok := got.Get(); !ok || v != want {
+		t.Fatalf("UnmarshalJSON[%T](%s): got (v=%v, ok=%v), want (%v, true)", want, data, v, ok, want)
+	}
+}
+
+func TestUnmarshalJSONScalarMatchesEncodingJSON(t *testing.T) {
+	numbers := []string{
+		"0", "-0", "1", "-1", "42", "01", "+1", "1.0", "1.5", "-", "1e3", "1E+2", "1e", "1.", ".5",
+		"127", "128", "-128", "-129", "255", "256", "65536", "2147483648", "4294967296",
+		"9223372036854775807", "9223372036854775808", "18446744073709551615", "18446744073709551616",
+		"1e400", "0x10", "Infinity", "NaN", `"1"`, "true", "1_000",
+	}
+	for _, data := range numbers {
+		checkUnmarshalMatches[int](t, data)
+		checkUnmarshalMatches[int8](t, data)
+		checkUnmarshalMatches[int16](t, data)
+		checkUnmarshalMatches[int32](t, data)
+		checkUnmarshalMatches[int64](t, data)
+		checkUnmarshalMatches[uint](t, data)
+		checkUnmarshalMatches[uint8](t, data)
+		checkUnmarshalMatches[uint16](t, data)
+		checkUnmarshalMatches[uint32](t, data)
+		checkUnmarshalMatches[uint64](t, data)
+		checkUnmarshalMatches[float64](t, data)
+	}
+
+	for _, data := range []string{"true", "false", "True", "1", `"true"`} {
+		checkUnmarshalMatches[bool](t, data)
+	}
+
+	strs := []string{
+		`""`, `"plain"`, `"日本語"`, `"esc\"aped"`, `"é"`, `"tab\there"`, `"a\\b"`,
+		"\"raw\ttab\"", "\"bad\xff\"", `"unterminated`, `1`,
+	}
+	for _, data := range strs {
+		checkUnmarshalMatches[string](t, data)
+	}
+}
+
+func TestUnmarshalJSONScalarAllocs(t *testing.T) {
+	var (
+		i Optional[int64]
+		b Optional[bool]
+		f Optional[float64]
+
+		intData, boolData, floatData = []byte("12345"), []byte("true"), []byte("-1.25e3")
+	)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = i.UnmarshalJSON(intData)
+		_ = b.UnmarshalJSON(boolData)
+		_ = f.UnmarshalJSON(floatData)
+	})
+	if allocs != 0 {
+		t.Fatalf("UnmarshalJSON: got %v allocations, want 0", allocs)
+	}
+}
@@ -0,0 +1,27 @@
+package optional
+
+import "sync"
+
+// Lazy is an Optional computed by a supplier on first access. The supplier
+// runs at most once, even under concurrent use; a success is cached as a
+// present value and a failure as an empty one with its error.
+type Lazy[T any] struct {
+	load func() (T, error)
+}
+
+// NewLazy returns a Lazy that calls supplier on first access.
+func NewLazy[T any](supplier func() (T, error)) *Lazy[T] {
+	return &Lazy[T]{load: sync.OnceValues(supplier)}
+}
+
+// Get runs the supplier if needed and returns its value, or an empty
+// Optional if it failed.
+func (l *Lazy[T]) Get() Optional[T] {
+	return FromTuple(l.load())
+}
+
+// Err runs the supplier if needed and returns its error.
+func (l *Lazy[T]) Err() error {
+	_, err := l.load()
+	return err
+}
@@ -0,0 +1,56 @@
+package optional
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestLazyRunsOnce(t *testing.T) {
+	calls := 0
+	l := NewLazy(func() (int, error) {
+		calls++
+		return 42, nil
+	})
+	if calls != 0 {
+		t.Fatalf("NewLazy: supplier should not run before first access")
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, ok := l.Get().Get(); !ok || v != 42 {
+				t.Errorf("Get: got (v=%v, ok=%v), want (42, true)", v, ok)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := l.Err(); err != nil {
+		t.Fatalf("Err: unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("supplier calls: got %d, want 1", calls)
+	}
+}
+
+func TestLazyError(t *testing.T) {
+	errLoad := errors.New("load failed")
+	calls := 0
+	l := NewLazy(func() (string, error) {
+		calls++
+		return "ignored", errLoad
+	})
+
+	if !l.Get().IsEmpty() {
+		t.Fatalf("Get: expected empty Optional when the supplier fails")
+	}
+	if err := l.Err(); !errors.Is(err, errLoad) {
+		t.Fatalf("Err: got %v, want %v", err, errLoad)
+	}
+	if calls != 1 {
+		t.Fatalf("supplier calls: got %d, want 1", calls)
+	}
+}
@@ -0,0 +1,20 @@
+package optional
+
+// SequenceMap is the map form of Sequence: it returns the values of m if
+// every Optional is present, and an empty Optional otherwise.
+func SequenceMap[K comparable, V any](m map[K]Optional[V]) Optional[map[K]V] {
+	values := make(map[K]V, len(m))
+	for k, o := range m {
+		if !o.hasValue {
+			return Optional[map[K]V]{}
+		}
+		values[k] = o.value
+	}
+	return New(values)
+}
+
+// MapLookup returns m[k], or an empty Optional if k is not in m.
+func MapLookup[K comparable, V any](m map[K]V, k K) Optional[V] {
+	v, ok := m[k]
+	return FromOk(v, ok)
+}
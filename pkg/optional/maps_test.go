@@ -0,0 +1,38 @@
+package optional
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestSequenceMap(t *testing.T) {
+	got, ok := SequenceMap(map[string]Optional[int]{"a": New(1), "b": New(2)}).Get()
+	if want := map[string]int{"a": 1, "b": 2}; !ok || !maps.Equal(got, want) {
+		t.Fatalf("SequenceMap: got (v=%v, ok=%v), want (%v, true)", got, ok, want)
+	}
+
+	if !SequenceMap(map[string]Optional[int]{"a": New(1), "b": Empty[int]()}).IsEmpty() {
+		t.Fatalf("SequenceMap: expected empty when any value is empty")
+	}
+
+	if got, ok := SequenceMap[string, int](nil).Get(); !ok || len(got) != 0 {
+		t.Fatalf("SequenceMap(nil): got (v=%v, ok=%v), want (map[], true)", got, ok)
+	}
+}
+
+func TestMapLookup(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+
+	if v, ok := MapLookup(m, "a").Get(); !ok || v != 1 {
+		t.Fatalf("MapLookup: got (v=%v, ok=%v), want (1, true)", v, ok)
+	}
+	if v, ok := MapLookup(m, "zero").Get(); !ok || v != 0 {
+		t.Fatalf("MapLookup: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+	if !MapLookup(m, "missing").IsEmpty() {
+		t.Fatalf("MapLookup: expected empty for a missing key")
+	}
+	if !MapLookup[string, int](nil, "a").IsEmpty() {
+		t.Fatalf("MapLookup: expected empty for a nil map")
+	}
+}
@@ -0,0 +1,37 @@
+package optional
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// EncodeMsgpack implements msgpack.CustomEncoder.
+// Empty optionals are encoded as msgpack nil.
+func (o Optional[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !o.hasValue {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(o.value)
+}
+
+// DecodeMsgpack implements msgpack.CustomDecoder.
+// A nil value unsets the optional; otherwise it decodes into T and sets it.
+func (o *Optional[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+	if code == msgpcode.Nil {
+		if err := dec.DecodeNil(); err != nil {
+			return err
+		}
+		o.Unset()
+		return nil
+	}
+	var v T
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
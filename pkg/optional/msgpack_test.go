@@ -0,0 +1,83 @@
+package optional
+
+import (
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+var (
+	_ msgpack.CustomEncoder = Optional[int]{}
+	_ msgpack.CustomDecoder = (*Optional[int])(nil)
+)
+
+type msgpackPayload struct {
+	ID    Optional[int64]    `msgpack:"id"`
+	Name  Optional[string]   `msgpack:"name"`
+	Score Optional[float64]  `msgpack:"score"`
+	Tags  Optional[[]string] `msgpack:"tags"`
+}
+
+func TestMsgpackEmptyIsNil(t *testing.T) {
+	got, err := msgpack.Marshal(Empty[int]())
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != 0xc0 {
+		t.Fatalf("Marshal(empty): got %x, want c0", got)
+	}
+
+	o := New(1)
+	if err := msgpack.Unmarshal(got, &o); err != nil {
+		t.Fatalf("Unmarshal: unexpected error: %v", err)
+	}
+	if !o.IsEmpty() {
+		t.Fatalf("Unmarshal(nil) should unset the Optional")
+	}
+}
+
+func TestMsgpackPresentMatchesPlainEncoding(t *testing.T) {
+	got, err := msgpack.Marshal(New("abc"))
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+	want, _ := msgpack.Marshal("abc")
+	if string(got) != string(want) {
+		t.Fatalf("Marshal: got %x, want %x", got, want)
+	}
+}
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	in := msgpackPayload{ID: New[int64](7), Score: New(0.0), Tags: New([]string{"a"})}
+
+	data, err := msgpack.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+
+	var out msgpackPayload
+	if err := msgpack.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal: unexpected error: %v", err)
+	}
+
+	if v, ok := out.ID.Get(); !ok || v != 7 {
+		t.Fatalf("ID: got (v=%v, ok=%v), want (7, true)", v, ok)
+	}
+	if !out.Name.IsEmpty() {
+		t.Fatalf("Name: should be empty")
+	}
+	if v, ok := out.Score.Get(); !ok || v != 0 {
+		t.Fatalf("Score: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+	if v, ok := out.Tags.Get(); !ok || len(v) != 1 || v[0] != "a" {
+		t.Fatalf("Tags: got (v=%v, ok=%v), want ([a], true)", v, ok)
+	}
+}
+
+func TestMsgpackDecodeInvalid(t *testing.T) {
+	data, _ := msgpack.Marshal("not a number")
+	var o Optional[int]
+	if err := msgpack.Unmarshal(data, &o); err == nil {
+		t.Fatalf("Unmarshal: expected error for invalid value")
+	}
+}
@@ -0,0 +1,116 @@
+package optional
+
+import (
+	"slices"
+	"sync"
+)
+
+// Observable is an Optional that notifies subscribers whenever it is set or
+// unset. The zero value is empty and ready to use.
+//
+// Subscribers run synchronously in the goroutine that changed the value,
+// one change at a time and in subscription order. They may call Get but
+// must not call Set, Unset, or a cancel function on the same Observable.
+type Observable[T any] struct {
+	// wmu serializes changes together with their notifications, so every
+	// subscriber sees them in the same order.
+	wmu sync.Mutex
+
+	mu sync.RWMutex
+	o  Optional[T]
+	// subs holds the live subscriptions in subscription order. It is
+	// copied on cancel rather than modified in place, so a notification
+	// can iterate over it without holding mu.
+	subs   []subscription[T]
+	nextID int
+}
+
+type subscription[T any] struct {
+	id int
+	f  func(Optional[T])
+}
+
+// Get returns the current value.
+func (ob *Observable[T]) Get() Optional[T] {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	return ob.o
+}
+
+// Set stores value and notifies subscribers.
+func (ob *Observable[T]) Set(value T) {
+	ob.store(New(value))
+}
+
+// Unset empties the optional. Subscribers are notified only if a value was
+// present.
+func (ob *Observable[T]) Unset() {
+	ob.wmu.Lock()
+	defer ob.wmu.Unlock()
+	if ob.Get().IsEmpty() {
+		return
+	}
+	ob.storeLocked(Empty[T]())
+}
+
+func (ob *Observable[T]) store(o Optional[T]) {
+	ob.wmu.Lock()
+	defer ob.wmu.Unlock()
+	ob.storeLocked(o)
+}
+
+func (ob *Observable[T]) storeLocked(o Optional[T]) {
+	ob.mu.Lock()
+	ob.o = o
+	subs := ob.subs
+	ob.mu.Unlock()
+
+	for _, s := range subs {
+		s.f(o)
+	}
+}
+
+// Subscribe registers f to be called with the new value after every change.
+// The returned function removes the subscription.
+func (ob *Observable[T]) Subscribe(f func(Optional[T])) (cancel func()) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	id := ob.nextID
+	ob.nextID++
+	ob.subs = append(ob.subs, subscription[T]{id: id, f: f})
+
+	return func() {
+		ob.mu.Lock()
+		defer ob.mu.Unlock()
+		i := slices.IndexFunc(ob.subs, func(s subscription[T]) bool { return s.id == id })
+		if i >= 0 {
+			ob.subs = slices.Concat(ob.subs[:i], ob.subs[i+1:])
+		}
+	}
+}
+
+// Watch returns a channel that receives the new value after every change.
+// The channel holds only the latest value: a slow reader skips intermediate
+// changes rather than blocking writers. The returned function removes the
+// subscription and closes the channel.
+func (ob *Observable[T]) Watch() (<-chan Optional[T], func()) {
+	ch := make(chan Optional[T], 1)
+	unsubscribe := ob.Subscribe(func(o Optional[T]) {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- o
+	})
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			// Wait for any in-flight notification before closing.
+			ob.wmu.Lock()
+			defer ob.wmu.Unlock()
+			unsubscribe()
+			close(ch)
+		})
+	}
+}
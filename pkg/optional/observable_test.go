@@ -0,0 +1,91 @@
+package optional
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestObservableSubscribe(t *testing.T) {
+	var ob Observable[int]
+	var got []Optional[int]
+	cancel := ob.Subscribe(func(o Optional[int]) {
+		got = append(got, o)
+	})
+
+	ob.Set(1)
+	ob.Unset()
+	ob.Unset()
+	ob.Set(2)
+	cancel()
+	ob.Set(3)
+
+	want := []Optional[int]{New(1), Empty[int](), New(2)}
+	if len(got) != len(want) {
+		t.Fatalf("notifications: got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !Equal(got[i], want[i]) {
+			t.Fatalf("notifications: got %v, want %v", got, want)
+		}
+	}
+	if v, ok := ob.Get().Get(); !ok || v != 3 {
+		t.Fatalf("Get: got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+}
+
+func TestObservableSubscribeOrder(t *testing.T) {
+	var ob Observable[string]
+	var order []int
+	for i := range 3 {
+		ob.Subscribe(func(Optional[string]) { order = append(order, i) })
+	}
+	ob.Set("x")
+
+	if len(order) != 3 || order[0] != 0 || order[1] != 1 || order[2] != 2 {
+		t.Fatalf("subscriber order: got %v, want [0 1 2]", order)
+	}
+}
+
+func TestObservableCancel(t *testing.T) {
+	var ob Observable[int]
+	var got []string
+	ob.Subscribe(func(Optional[int]) { got = append(got, "a") })
+	cancelB := ob.Subscribe(func(Optional[int]) { got = append(got, "b") })
+	ob.Subscribe(func(Optional[int]) { got = append(got, "c") })
+	for range 1000 {
+		ob.Subscribe(func(Optional[int]) {})()
+	}
+
+	cancelB()
+	cancelB()
+	ob.Set(1)
+	if want := []string{"a", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("notification order after cancel: got %v, want %v", got, want)
+	}
+	if n := len(ob.subs); n != 2 {
+		t.Fatalf("live subscriptions: got %d, want 2", n)
+	}
+}
+
+func TestObservableWatch(t *testing.T) {
+	var ob Observable[string]
+	ch, cancel := ob.Watch()
+
+	ob.Set("a")
+	ob.Set("b")
+	if v, ok := (<-ch).Get(); !ok || v != "b" {
+		t.Fatalf("Watch: got (v=%q, ok=%v), want (\"b\", true)", v, ok)
+	}
+
+	ob.Unset()
+	if !(<-ch).IsEmpty() {
+		t.Fatalf("Watch: expected empty Optional after Unset")
+	}
+
+	cancel()
+	cancel()
+	ob.Set("c")
+	if _, ok := <-ch; ok {
+		t.Fatalf("Watch: channel should be closed after cancel")
+	}
+}
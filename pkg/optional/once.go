@@ -0,0 +1,36 @@
+package optional
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrAlreadySet is returned by Once.Set when a value has already been set.
+var ErrAlreadySet = errors.New("optional: value already set")
+
+// Once is an Optional that can be set only once, for values that must not
+// be overwritten after startup. The zero value is empty and safe for
+// concurrent use.
+type Once[T any] struct {
+	mu sync.RWMutex
+	o  Optional[T]
+}
+
+// Set stores value if none has been set yet. Otherwise it leaves the
+// current value in place and returns ErrAlreadySet.
+func (s *Once[T]) Set(value T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.o.hasValue {
+		return ErrAlreadySet
+	}
+	s.o.Set(value)
+	return nil
+}
+
+// Get returns the value, or an empty Optional if Set has not been called.
+func (s *Once[T]) Get() Optional[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.o
+}
@@ -0,0 +1,49 @@
+package optional
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestOnce(t *testing.T) {
+	var o Once[string]
+	if !o.Get().IsEmpty() {
+		t.Fatalf("Get: zero Once should be empty")
+	}
+
+	if err := o.Set("first"); err != nil {
+		t.Fatalf("Set: unexpected error: %v", err)
+	}
+	if err := o.Set("second"); !errors.Is(err, ErrAlreadySet) {
+		t.Fatalf("Set: got %v, want %v", err, ErrAlreadySet)
+	}
+	if v, ok := o.Get().Get(); !ok || v != "first" {
+		t.Fatalf("Get: got (v=%q, ok=%v), want (\"first\", true)", v, ok)
+	}
+}
+
+func TestOnceConcurrentSet(t *testing.T) {
+	var (
+		o    Once[int]
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		wins int
+	)
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if o.Set(i) == nil {
+				mu.Lock()
+				wins++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if wins != 1 {
+		t.Fatalf("Set: got %d successful calls, want 1", wins)
+	}
+}
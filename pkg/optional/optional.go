@@ -1,6 +1,13 @@
 package optional
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// ErrEmpty is returned by GetOrErr when the Optional has no value.
+var ErrEmpty = errors.New("optional: empty value")
 
 type Optional[T any] struct {
 	value    T
@@ -27,6 +34,78 @@ func FromPtr[T any](value *T) Optional[T] {
 	}
 }
 
+// FromTuple lifts a (T, error) result into an Optional.
+// A non-nil error yields an empty Optional.
+func FromTuple[T any](value T, err error) Optional[T] {
+	if err != nil {
+		return Optional[T]{}
+	}
+	return New(value)
+}
+
+// FromOk lifts a comma-ok result into an Optional.
+func FromOk[T any](value T, ok bool) Optional[T] {
+	if !ok {
+		return Optional[T]{}
+	}
+	return New(value)
+}
+
+// FromNonZero returns an empty Optional if value is T's zero value, and a
+// present one otherwise. It lifts APIs that use the zero value for "unset".
+func FromNonZero[T comparable](value T) Optional[T] {
+	var zero T
+	return FromOk(value, value != zero)
+}
+
+// FromNonZeroValue is like FromNonZero for types that are not comparable,
+// such as slices, maps and structs containing them. A value is zero as
+// defined by reflect.Value.IsZero, so an empty but non-nil slice is present.
+func FromNonZeroValue[T any](value T) Optional[T] {
+	return FromOk(value, !reflect.ValueOf(&value).Elem().IsZero())
+}
+
+// NewIf returns a present Optional holding value if pred(value) is true,
+// and an empty Optional otherwise.
+func NewIf[T any](value T, pred func(T) bool) Optional[T] {
+	return FromOk(value, pred(value))
+}
+
+// When returns a present Optional holding value if cond is true, and an
+// empty Optional otherwise. It builds sparse payloads without an if per
+// field.
+func When[T any](cond bool, value T) Optional[T] {
+	return FromOk(value, cond)
+}
+
+// WhenFunc is like When, but only calls f to compute the value if cond is
+// true.
+func WhenFunc[T any](cond bool, f func() T) Optional[T] {
+	if !cond {
+		return Optional[T]{}
+	}
+	return New(f())
+}
+
+// Unless is When with the condition negated.
+func Unless[T any](cond bool, value T) Optional[T] {
+	return FromOk(value, !cond)
+}
+
+// NonNil is like New, but returns an empty Optional if value is a nil
+// pointer, map, slice, channel, function or interface.
+func NonNil[T any](value T) Optional[T] {
+	rv := reflect.ValueOf(&value).Elem()
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func,
+		reflect.Interface, reflect.UnsafePointer:
+		if rv.IsNil() {
+			return Optional[T]{}
+		}
+	}
+	return New(value)
+}
+
 func Empty[T any]() Optional[T] {
 	return Optional[T]{}
 }
@@ -35,10 +114,45 @@ func (o Optional[T]) IsEmpty() bool {
 	return !o.hasValue
 }
 
+// IsZero reports whether the Optional is empty.
+// It lets encoding/json drop empty fields tagged with omitzero.
+func (o Optional[T]) IsZero() bool {
+	return !o.hasValue
+}
+
 func (o Optional[T]) Get() (T, bool) {
 	return o.value, o.hasValue
 }
 
+// MustGet returns the value or panics if the Optional is empty.
+func (o Optional[T]) MustGet() T {
+	if !o.hasValue {
+		panic("optional: MustGet called on empty Optional[" + reflect.TypeFor[T]().String() + "]")
+	}
+	return o.value
+}
+
+// Expect returns the value or panics with msg if the Optional is empty.
+func (o Optional[T]) Expect(msg string) T {
+	if !o.hasValue {
+		panic(msg)
+	}
+	return o.value
+}
+
+// OkOr returns the value, or err if the Optional is empty.
+func (o Optional[T]) OkOr(err error) (T, error) {
+	if !o.hasValue {
+		return o.value, err
+	}
+	return o.value, nil
+}
+
+// GetOrErr returns the value, or ErrEmpty if the Optional is empty.
+func (o Optional[T]) GetOrErr() (T, error) {
+	return o.OkOr(ErrEmpty)
+}
+
 // ToPtr creates a new copy of T
 func (o Optional[T]) ToPtr() *T {
 	if !o.hasValue {
@@ -48,6 +162,16 @@ func (o Optional[T]) ToPtr() *T {
 	return &v
 }
 
+// MutablePtr returns a pointer to the value stored in o, or nil if o is
+// empty. Unlike ToPtr it does not copy: writes through the pointer change
+// o, and the pointer stays valid only until o is next set or unset.
+func (o *Optional[T]) MutablePtr() *T {
+	if !o.hasValue {
+		return nil
+	}
+	return &o.value
+}
+
 func (o Optional[T]) Or(value T) T {
 	if !o.hasValue {
 		return value
@@ -55,6 +179,88 @@ func (o Optional[T]) Or(value T) T {
 	return o.value
 }
 
+// OrElseGet returns the value if present, otherwise the result of supplier.
+// Unlike Or, the fallback is only computed when it is needed.
+func (o Optional[T]) OrElseGet(supplier func() T) T {
+	if !o.hasValue {
+		return supplier()
+	}
+	return o.value
+}
+
+// Map applies f to the value of o and wraps the result.
+// An empty Optional stays empty and f is not called.
+func Map[T, U any](o Optional[T], f func(T) U) Optional[U] {
+	if !o.hasValue {
+		return Optional[U]{}
+	}
+	return New(f(o.value))
+}
+
+// FlatMap applies f to the value of o and returns its result as is.
+// An empty Optional stays empty and f is not called.
+func FlatMap[T, U any](o Optional[T], f func(T) Optional[U]) Optional[U] {
+	if !o.hasValue {
+		return Optional[U]{}
+	}
+	return f(o.value)
+}
+
+// AndThen is the method form of FlatMap for functions that keep the type.
+func (o Optional[T]) AndThen(f func(T) Optional[T]) Optional[T] {
+	return FlatMap(o, f)
+}
+
+// Filter returns o if it is present and its value satisfies pred.
+// Otherwise it returns an empty Optional.
+func (o Optional[T]) Filter(pred func(T) bool) Optional[T] {
+	if !o.hasValue || !pred(o.value) {
+		return Optional[T]{}
+	}
+	return o
+}
+
+// Match calls onValue with the value if present, otherwise onEmpty.
+func (o Optional[T]) Match(onValue func(T), onEmpty func()) {
+	if !o.hasValue {
+		onEmpty()
+		return
+	}
+	onValue(o.value)
+}
+
+// IfPresent calls f with the value if the Optional is not empty.
+func (o Optional[T]) IfPresent(f func(T)) {
+	if o.hasValue {
+		f(o.value)
+	}
+}
+
+// IfEmpty calls f if the Optional is empty.
+func (o Optional[T]) IfEmpty(f func()) {
+	if !o.hasValue {
+		f()
+	}
+}
+
+// MatchResult is like Match, but returns the result of the called function.
+func MatchResult[T, R any](o Optional[T], onValue func(T) R, onEmpty func() R) R {
+	if !o.hasValue {
+		return onEmpty()
+	}
+	return onValue(o.value)
+}
+
+// Coalesce returns the first present Optional, or an empty one if none is.
+func Coalesce[T any](opts ...Optional[T]) Optional[T] {
+	for _, o := range opts {
+		if o.hasValue {
+			return o
+		}
+	}
+	return Optional[T]{}
+}
+
 func (o *Optional[T]) Set(value T) {
 	o.hasValue = true
 	o.value = value
@@ -65,15 +271,60 @@ func (o *Optional[T]) Unset() {
 	o.value = *new(T)
 }
 
+// Take returns the current Optional and leaves the receiver empty.
+func (o *Optional[T]) Take() Optional[T] {
+	taken := *o
+	o.Unset()
+	return taken
+}
+
+// GetOrInsert stores value if the Optional is empty and returns the value
+// now held.
+func (o *Optional[T]) GetOrInsert(value T) T {
+	if !o.hasValue {
+		o.Set(value)
+	}
+	return o.value
+}
+
+// GetOrInsertWith is like GetOrInsert, but supplier is only called when the
+// Optional is empty.
+func (o *Optional[T]) GetOrInsertWith(supplier func() T) T {
+	if !o.hasValue {
+		o.Set(supplier())
+	}
+	return o.value
+}
+
 // MarshalJSON implements json.Marshaler.
 // Empty optionals are encoded as JSON null.
 func (o Optional[T]) MarshalJSON() ([]byte, error) {
 	if !o.hasValue {
 		return []byte("null"), nil
 	}
+	if out, ok := appendJSONScalar(nil, o.value); ok {
+		return out, nil
+	}
 	return json.Marshal(o.value)
 }
 
+// AppendJSON appends the JSON encoding of o to dst, producing the same
+// bytes as MarshalJSON. Strings, booleans and numbers are appended directly,
+// so hot encoding paths can reuse a buffer.
+func (o Optional[T]) AppendJSON(dst []byte) ([]byte, error) {
+	if !o.hasValue {
+		return append(dst, "null"...), nil
+	}
+	if out, ok := appendJSONScalar(dst, o.value); ok {
+		return out, nil
+	}
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, data...), nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // JSON null unsets the optional; otherwise it parses into T and sets it.
 func (o *Optional[T]) UnmarshalJSON(data []byte) error {
@@ -82,21 +333,12 @@ func (o *Optional[T]) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	// Fast-path for null (also handles "null" with surrounding whitespace via Valid check below)
-	if len(data) == 4 && string(data) == "null" {
+	if isJSONNull(data) {
 		o.Unset()
 		return nil
 	}
 
-	// More robust null handling (whitespace, etc.)
-	trimmed := make([]byte, 0, len(data))
-	for _, b := range data {
-		if b != ' ' && b != '\n' && b != '\r' && b != '\t' {
-			trimmed = append(trimmed, b)
-		}
-	}
-	if string(trimmed) == "null" {
-		o.Unset()
+	if o.unmarshalJSONScalar(data) {
 		return nil
 	}
 
@@ -107,3 +349,21 @@ func (o *Optional[T]) UnmarshalJSON(data []byte) error {
 	o.Set(v)
 	return nil
 }
+
+// isJSONNull reports whether data is the JSON literal null, possibly
+// surrounded by whitespace. It scans in place instead of trimming a copy,
+// since it runs once per Optional field.
+func isJSONNull(data []byte) bool {
+	start, end := 0, len(data)
+	for start < end && isJSONSpace(data[start]) {
+		start++
+	}
+	for end > start && isJSONSpace(data[end-1]) {
+		end--
+	}
+	return string(data[start:end]) == "null"
+}
+
+func isJSONSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
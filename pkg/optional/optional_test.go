@@ -1,6 +1,13 @@
 package optional
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestZeroValueIsEmpty(t *testing.T) {
 	var o Optional[int]
@@ -205,7 +212,7 @@ func TestToPtr_ShallowCopyReferenceTypes_TableDriven(t *testing.T) {
 
 				if !tc.expectOptionalChanged {
 					// keep for symmetry; currently not used in slice case
-					orig, _ := New(v).Get()
+					orig := New(v).MustGet()
 					if len(got) != len(orig) {
 						t.Fatalf("unexpected change: got %v, want %v", got, orig)
 					}
@@ -462,3 +469,546 @@ func TestFromPtrReferenceTypes_TableDriven(t *testing.T) {
 		}
 	})
 }
+
+func TestMap(t *testing.T) {
+	t.Run("present", func(t *testing.T) {
+		o := Map(New(21), func(v int) string {
+			return strconv.Itoa(v * 2)
+		})
+		v, ok := o.Get()
+		if !ok || v != "42" {
+			t.Fatalf("Get: got (v=%q, ok=%v), want (\"42\", true)", v, ok)
+		}
+	})
+
+	t.Run("empty does not call f", func(t *testing.T) {
+		called := false
+		o := Map(Empty[int](), func(v int) string {
+			called = true
+			return strconv.Itoa(v)
+		})
+		if !o.IsEmpty() {
+			t.Fatalf("Map on empty should return empty Optional")
+		}
+		if called {
+			t.Fatalf("Map on empty must not call f")
+		}
+	})
+}
+
+func TestFlatMapAndAndThen(t *testing.T) {
+	parse := func(s string) Optional[int] {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return Empty[int]()
+		}
+		return New(v)
+	}
+	positive := func(v int) Optional[int] {
+		if v <= 0 {
+			return Empty[int]()
+		}
+		return New(v)
+	}
+
+	cases := []struct {
+		name   string
+		in     Optional[string]
+		want   int
+		wantOk bool
+	}{
+		{name: "empty input", in: Empty[string]()},
+		{name: "first step fails", in: New("x")},
+		{name: "second step fails", in: New("-3")},
+		{name: "all steps succeed", in: New("7"), want: 7, wantOk: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, ok := FlatMap(tc.in, parse).AndThen(positive).Get()
+			if ok != tc.wantOk || v != tc.want {
+				t.Fatalf("got (v=%v, ok=%v), want (%v, %v)", v, ok, tc.want, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	if v, ok := New(4).Filter(even).Get(); !ok || v != 4 {
+		t.Fatalf("Filter(pass): got (v=%v, ok=%v), want (4, true)", v, ok)
+	}
+	if o := New(3).Filter(even); !o.IsEmpty() {
+		t.Fatalf("Filter(fail) should return empty Optional")
+	}
+
+	called := false
+	o := Empty[int]().Filter(func(int) bool {
+		called = true
+		return true
+	})
+	if !o.IsEmpty() || called {
+		t.Fatalf("Filter on empty: got (empty=%v, called=%v), want (true, false)", o.IsEmpty(), called)
+	}
+}
+
+func TestOrElseGet(t *testing.T) {
+	calls := 0
+	supplier := func() int {
+		calls++
+		return 42
+	}
+
+	if got := New(10).OrElseGet(supplier); got != 10 {
+		t.Fatalf("OrElseGet on present: got %v, want 10", got)
+	}
+	if calls != 0 {
+		t.Fatalf("OrElseGet on present called supplier %d times, want 0", calls)
+	}
+
+	if got := Empty[int]().OrElseGet(supplier); got != 42 {
+		t.Fatalf("OrElseGet on empty: got %v, want 42", got)
+	}
+	if calls != 1 {
+		t.Fatalf("OrElseGet on empty called supplier %d times, want 1", calls)
+	}
+}
+
+func TestMustGet(t *testing.T) {
+	if got := New("x").MustGet(); got != "x" {
+		t.Fatalf("MustGet: got %q, want \"x\"", got)
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("MustGet on empty should panic")
+		}
+		msg, _ := r.(string)
+		if !strings.Contains(msg, "Optional[time.Duration]") {
+			t.Fatalf("panic message %q should mention the type", msg)
+		}
+	}()
+	Empty[time.Duration]().MustGet()
+}
+
+func TestExpect(t *testing.T) {
+	if got := New(8080).Expect("port must be set"); got != 8080 {
+		t.Fatalf("Expect: got %v, want 8080", got)
+	}
+
+	defer func() {
+		if r := recover(); r != "config.port must be set" {
+			t.Fatalf("panic value: got %v, want %q", r, "config.port must be set")
+		}
+	}()
+	Empty[int]().Expect("config.port must be set")
+}
+
+func TestOkOrAndGetOrErr(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	v, err := New(3).OkOr(errMissing)
+	if err != nil || v != 3 {
+		t.Fatalf("OkOr on present: got (v=%v, err=%v), want (3, nil)", v, err)
+	}
+
+	v, err = Empty[int]().OkOr(errMissing)
+	if err != errMissing || v != 0 {
+		t.Fatalf("OkOr on empty: got (v=%v, err=%v), want (0, %v)", v, err, errMissing)
+	}
+
+	v, err = New(5).GetOrErr()
+	if err != nil || v != 5 {
+		t.Fatalf("GetOrErr on present: got (v=%v, err=%v), want (5, nil)", v, err)
+	}
+
+	_, err = Empty[int]().GetOrErr()
+	if !errors.Is(err, ErrEmpty) {
+		t.Fatalf("GetOrErr on empty: got err=%v, want ErrEmpty", err)
+	}
+}
+
+func TestFromTuple(t *testing.T) {
+	if v, ok := FromTuple(strconv.Atoi("12")).Get(); !ok || v != 12 {
+		t.Fatalf("FromTuple(ok): got (v=%v, ok=%v), want (12, true)", v, ok)
+	}
+	if o := FromTuple(strconv.Atoi("x")); !o.IsEmpty() {
+		t.Fatalf("FromTuple(err) should be empty")
+	}
+}
+
+func TestFromOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	v, ok := m["a"]
+	if got, gotOk := FromOk(v, ok).Get(); !gotOk || got != 1 {
+		t.Fatalf("FromOk(map hit): got (v=%v, ok=%v), want (1, true)", got, gotOk)
+	}
+
+	v, ok = m["b"]
+	if o := FromOk(v, ok); !o.IsEmpty() {
+		t.Fatalf("FromOk(map miss) should be empty")
+	}
+
+	var x any = "s"
+	s, ok := x.(string)
+	if got, gotOk := FromOk(s, ok).Get(); !gotOk || got != "s" {
+		t.Fatalf("FromOk(type assertion): got (v=%q, ok=%v), want (\"s\", true)", got, gotOk)
+	}
+
+	ch := make(chan int)
+	close(ch)
+	v, ok = <-ch
+	if o := FromOk(v, ok); !o.IsEmpty() {
+		t.Fatalf("FromOk(closed channel) should be empty")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	var got []string
+	onValue := func(v int) { got = append(got, "value:"+strconv.Itoa(v)) }
+	onEmpty := func() { got = append(got, "empty") }
+
+	New(1).Match(onValue, onEmpty)
+	Empty[int]().Match(onValue, onEmpty)
+
+	if len(got) != 2 || got[0] != "value:1" || got[1] != "empty" {
+		t.Fatalf("Match calls: got %v, want [value:1 empty]", got)
+	}
+}
+
+func TestMatchResult(t *testing.T) {
+	describe := func(o Optional[int]) string {
+		return MatchResult(o,
+			func(v int) string { return "n=" + strconv.Itoa(v) },
+			func() string { return "none" },
+		)
+	}
+
+	if got := describe(New(3)); got != "n=3" {
+		t.Fatalf("MatchResult on present: got %q, want \"n=3\"", got)
+	}
+	if got := describe(Empty[int]()); got != "none" {
+		t.Fatalf("MatchResult on empty: got %q, want \"none\"", got)
+	}
+}
+
+func TestIfPresent(t *testing.T) {
+	var got []int
+	record := func(v int) { got = append(got, v) }
+
+	New(1).IfPresent(record)
+	Empty[int]().IfPresent(record)
+	New(0).IfPresent(record)
+
+	if len(got) != 2 || got[0] != 1 || got[1] != 0 {
+		t.Fatalf("IfPresent calls: got %v, want [1 0]", got)
+	}
+}
+
+func TestIfEmpty(t *testing.T) {
+	calls := 0
+	count := func() { calls++ }
+
+	New(1).IfEmpty(count)
+	if calls != 0 {
+		t.Fatalf("IfEmpty on present called f %d times, want 0", calls)
+	}
+
+	Empty[int]().IfEmpty(count)
+	if calls != 1 {
+		t.Fatalf("IfEmpty on empty called f %d times, want 1", calls)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	cli, env, file := Empty[string](), New("env"), New("file")
+
+	if v, ok := Coalesce(cli, env, file).Get(); !ok || v != "env" {
+		t.Fatalf("Coalesce: got (v=%q, ok=%v), want (\"env\", true)", v, ok)
+	}
+	if o := Coalesce(cli, Empty[string]()); !o.IsEmpty() {
+		t.Fatalf("Coalesce of empties should be empty")
+	}
+	if o := Coalesce[string](); !o.IsEmpty() {
+		t.Fatalf("Coalesce with no arguments should be empty")
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	if !Empty[int]().IsZero() {
+		t.Fatalf("IsZero on empty: got false, want true")
+	}
+	if New(0).IsZero() {
+		t.Fatalf("IsZero on present zero value: got true, want false")
+	}
+}
+
+func TestJSONOmitZero(t *testing.T) {
+	type patch struct {
+		Name  Optional[string] `json:"name,omitzero"`
+		Count Optional[int]    `json:"count,omitzero"`
+		Note  Optional[string] `json:"note"`
+	}
+
+	got, err := json.Marshal(patch{Count: New(0)})
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `{"count":0,"note":null}`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+}
+
+func TestGetOrInsert(t *testing.T) {
+	var o Optional[int]
+	if got := o.GetOrInsert(1); got != 1 {
+		t.Fatalf("GetOrInsert on empty: got %v, want 1", got)
+	}
+	if got := o.GetOrInsert(2); got != 1 {
+		t.Fatalf("GetOrInsert on present: got %v, want 1", got)
+	}
+	if v, ok := o.Get(); !ok || v != 1 {
+		t.Fatalf("Get after GetOrInsert: got (v=%v, ok=%v), want (1, true)", v, ok)
+	}
+}
+
+func TestGetOrInsertWith(t *testing.T) {
+	calls := 0
+	supplier := func() string {
+		calls++
+		return "x"
+	}
+
+	var o Optional[string]
+	if got := o.GetOrInsertWith(supplier); got != "x" {
+		t.Fatalf("GetOrInsertWith on empty: got %q, want \"x\"", got)
+	}
+	if got := o.GetOrInsertWith(supplier); got != "x" {
+		t.Fatalf("GetOrInsertWith on present: got %q, want \"x\"", got)
+	}
+	if calls != 1 {
+		t.Fatalf("supplier calls: got %d, want 1", calls)
+	}
+}
+
+func TestTake(t *testing.T) {
+	o := New("a")
+	taken := o.Take()
+	if v, ok := taken.Get(); !ok || v != "a" {
+		t.Fatalf("Take: got (v=%q, ok=%v), want (\"a\", true)", v, ok)
+	}
+	if !o.IsEmpty() {
+		t.Fatalf("Take: receiver should be empty afterwards")
+	}
+	if !o.Take().IsEmpty() {
+		t.Fatalf("Take on empty: expected empty Optional")
+	}
+}
+
+func TestMutablePtr(t *testing.T) {
+	type big struct{ A, B int }
+
+	var empty Optional[big]
+	if p := empty.MutablePtr(); p != nil {
+		t.Fatalf("MutablePtr on empty: got %v, want nil", p)
+	}
+
+	o := New(big{A: 1})
+	o.MutablePtr().B = 2
+	if v, ok := o.Get(); !ok || v != (big{A: 1, B: 2}) {
+		t.Fatalf("Get after MutablePtr edit: got (v=%+v, ok=%v), want ({A:1 B:2}, true)", v, ok)
+	}
+}
+
+func TestAppendJSON(t *testing.T) {
+	type named struct{ A int }
+	cases := []struct {
+		name string
+		in   interface {
+			AppendJSON([]byte) ([]byte, error)
+			MarshalJSON() ([]byte, error)
+		}
+	}{
+		{name: "empty", in: Empty[int]()},
+		{name: "bool", in: New(true)},
+		{name: "int", in: New(-42)},
+		{name: "uint64", in: New(uint64(1 << 63))},
+		{name: "string", in: New("a\"<b>")},
+		{name: "struct", in: New(named{A: 1})},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			want, err := tc.in.MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON: unexpected error: %v", err)
+			}
+			got, err := tc.in.AppendJSON([]byte("x"))
+			if err != nil {
+				t.Fatalf("AppendJSON: unexpected error: %v", err)
+			}
+			if string(got) != "x"+string(want) {
+				t.Fatalf("AppendJSON: got %s, want x%s", got, want)
+			}
+		})
+	}
+}
+
+func TestAppendJSONAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	present, empty := New(123456), Empty[int]()
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = present.AppendJSON(buf[:0])
+		buf, _ = empty.AppendJSON(buf)
+	})
+	if allocs != 0 {
+		t.Fatalf("AppendJSON: got %v allocations, want 0", allocs)
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	for _, data := range []string{"null", " null", "null\n", "\t\r\n null \n"} {
+		o := New(1)
+		if err := o.UnmarshalJSON([]byte(data)); err != nil {
+			t.Fatalf("UnmarshalJSON(%q): unexpected error: %v", data, err)
+		}
+		if !o.IsEmpty() {
+			t.Fatalf("UnmarshalJSON(%q): expected empty Optional", data)
+		}
+	}
+
+	var s Optional[string]
+	if err := s.UnmarshalJSON([]byte(` "null" `)); err != nil {
+		t.Fatalf("UnmarshalJSON: unexpected error: %v", err)
+	}
+	if v, ok := s.Get(); !ok || v != "null" {
+		t.Fatalf("UnmarshalJSON: got (v=%q, ok=%v), want (\"null\", true)", v, ok)
+	}
+}
+
+func TestFromNonZero(t *testing.T) {
+	if !FromNonZero(0).IsEmpty() || !FromNonZero("").IsEmpty() {
+		t.Fatalf("FromNonZero: zero values should give an empty Optional")
+	}
+	if v, ok := FromNonZero(5).Get(); !ok || v != 5 {
+		t.Fatalf("FromNonZero: got (v=%v, ok=%v), want (5, true)", v, ok)
+	}
+
+	type point struct{ X, Y int }
+	if !FromNonZero(point{}).IsEmpty() {
+		t.Fatalf("FromNonZero: zero struct should give an empty Optional")
+	}
+	if FromNonZero(point{Y: 1}).IsEmpty() {
+		t.Fatalf("FromNonZero: non-zero struct should be present")
+	}
+}
+
+func TestFromNonZeroValue(t *testing.T) {
+	type labels struct {
+		Names []string
+	}
+
+	cases := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{name: "nil slice", got: FromNonZeroValue([]int(nil)).IsEmpty(), want: true},
+		{name: "empty slice", got: FromNonZeroValue([]int{}).IsEmpty(), want: false},
+		{name: "nil map", got: FromNonZeroValue(map[string]int(nil)).IsEmpty(), want: true},
+		{name: "zero struct", got: FromNonZeroValue(labels{}).IsEmpty(), want: true},
+		{name: "non-zero struct", got: FromNonZeroValue(labels{Names: []string{"a"}}).IsEmpty(), want: false},
+		{name: "nil interface", got: FromNonZeroValue[any](nil).IsEmpty(), want: true},
+		{name: "zero int", got: FromNonZeroValue(0).IsEmpty(), want: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Fatalf("IsEmpty: got %v, want %v", tc.got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNonNil(t *testing.T) {
+	var (
+		nilPtr   *int
+		nilMap   map[string]int
+		nilSlice []int
+		nilChan  chan int
+		nilFunc  func()
+		nilErr   error
+		nilAny   any = nilPtr // non-nil interface holding a nil pointer
+	)
+	n := 1
+
+	cases := []struct {
+		name      string
+		wantEmpty bool
+		got       bool
+	}{
+		{name: "nil pointer", got: NonNil(nilPtr).IsEmpty(), wantEmpty: true},
+		{name: "nil map", got: NonNil(nilMap).IsEmpty(), wantEmpty: true},
+		{name: "nil slice", got: NonNil(nilSlice).IsEmpty(), wantEmpty: true},
+		{name: "nil chan", got: NonNil(nilChan).IsEmpty(), wantEmpty: true},
+		{name: "nil func", got: NonNil(nilFunc).IsEmpty(), wantEmpty: true},
+		{name: "nil interface", got: NonNil(nilErr).IsEmpty(), wantEmpty: true},
+		{name: "interface holding nil pointer", got: NonNil(nilAny).IsEmpty(), wantEmpty: false},
+		{name: "pointer", got: NonNil(&n).IsEmpty(), wantEmpty: false},
+		{name: "empty slice", got: NonNil([]int{}).IsEmpty(), wantEmpty: false},
+		{name: "zero int", got: NonNil(0).IsEmpty(), wantEmpty: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.wantEmpty {
+				t.Fatalf("IsEmpty: got %v, want %v", tc.got, tc.wantEmpty)
+			}
+		})
+	}
+}
+
+func TestNewIf(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	if v, ok := NewIf(3, positive).Get(); !ok || v != 3 {
+		t.Fatalf("NewIf: got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+	if !NewIf(-3, positive).IsEmpty() {
+		t.Fatalf("NewIf: expected empty when the predicate fails")
+	}
+}
+
+func TestWhenUnless(t *testing.T) {
+	if v, ok := When(true, 3).Get(); !ok || v != 3 {
+		t.Fatalf("When(true): got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+	if !When(false, 3).IsEmpty() {
+		t.Fatalf("When(false): expected empty")
+	}
+	if v, ok := Unless(false, 3).Get(); !ok || v != 3 {
+		t.Fatalf("Unless(false): got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+	if !Unless(true, 3).IsEmpty() {
+		t.Fatalf("Unless(true): expected empty")
+	}
+}
+
+func TestWhenFunc(t *testing.T) {
+	calls := 0
+	f := func() string {
+		calls++
+		return "x"
+	}
+
+	if v, ok := WhenFunc(true, f).Get(); !ok || v != "x" {
+		t.Fatalf("WhenFunc(true): got (v=%q, ok=%v), want (\"x\", true)", v, ok)
+	}
+	if !WhenFunc(false, f).IsEmpty() {
+		t.Fatalf("WhenFunc(false): expected empty")
+	}
+	if calls != 1 {
+		t.Fatalf("WhenFunc: f called %d times, want 1", calls)
+	}
+}
@@ -0,0 +1,74 @@
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Pair holds two values of possibly different types. It is encoded to JSON
+// as a two-element array.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a Pair holding first and second.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Values returns both values of the pair.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// String implements fmt.Stringer.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// MarshalJSON implements json.Marshaler.
+// The pair is encoded as [First, Second].
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{p.First, p.Second})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It expects a JSON array of exactly two elements.
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("optional: cannot unmarshal array of %d elements into Pair", len(raw))
+	}
+	var first A
+	if err := json.Unmarshal(raw[0], &first); err != nil {
+		return err
+	}
+	var second B
+	if err := json.Unmarshal(raw[1], &second); err != nil {
+		return err
+	}
+	p.First, p.Second = first, second
+	return nil
+}
+
+// Zip combines a and b into an Optional pair.
+// The result is empty unless both a and b are present.
+func Zip[A, B any](a Optional[A], b Optional[B]) Optional[Pair[A, B]] {
+	if !a.hasValue || !b.hasValue {
+		return Optional[Pair[A, B]]{}
+	}
+	return New(Pair[A, B]{First: a.value, Second: b.value})
+}
+
+// Unzip splits an Optional pair into two Optionals.
+// Both results are empty if o is empty.
+func Unzip[A, B any](o Optional[Pair[A, B]]) (Optional[A], Optional[B]) {
+	if !o.hasValue {
+		return Optional[A]{}, Optional[B]{}
+	}
+	return New(o.value.First), New(o.value.Second)
+}
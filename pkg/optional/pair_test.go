@@ -0,0 +1,82 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	cases := []struct {
+		name   string
+		a      Optional[int]
+		b      Optional[string]
+		wantOk bool
+	}{
+		{name: "both present", a: New(1), b: New("t1"), wantOk: true},
+		{name: "first empty", a: Empty[int](), b: New("t1")},
+		{name: "second empty", a: New(1), b: Empty[string]()},
+		{name: "both empty", a: Empty[int](), b: Empty[string]()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, ok := Zip(tc.a, tc.b).Get()
+			if ok != tc.wantOk {
+				t.Fatalf("ok: got %v, want %v", ok, tc.wantOk)
+			}
+			if ok && (p.First != 1 || p.Second != "t1") {
+				t.Fatalf("pair: got %+v, want {First:1 Second:t1}", p)
+			}
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip(Zip(New(1), New("t1")))
+	if v, ok := a.Get(); !ok || v != 1 {
+		t.Fatalf("first: got (v=%v, ok=%v), want (1, true)", v, ok)
+	}
+	if v, ok := b.Get(); !ok || v != "t1" {
+		t.Fatalf("second: got (v=%q, ok=%v), want (\"t1\", true)", v, ok)
+	}
+
+	a, b = Unzip(Empty[Pair[int, string]]())
+	if !a.IsEmpty() || !b.IsEmpty() {
+		t.Fatalf("Unzip of empty: got (%v, %v), want both empty", a.IsEmpty(), b.IsEmpty())
+	}
+}
+
+func TestPairAccessors(t *testing.T) {
+	p := NewPair("a", 1)
+	if first, second := p.Values(); first != "a" || second != 1 {
+		t.Fatalf("Values: got (%q, %d), want (\"a\", 1)", first, second)
+	}
+	if got := p.String(); got != "(a, 1)" {
+		t.Fatalf("String: got %q, want \"(a, 1)\"", got)
+	}
+}
+
+func TestPairJSON(t *testing.T) {
+	got, err := json.Marshal(Zip(New("a"), New(1)))
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `["a",1]`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+
+	var o Optional[Pair[string, int]]
+	if err := json.Unmarshal([]byte(` ["b", 2] `), &o); err != nil {
+		t.Fatalf("json.Unmarshal: unexpected error: %v", err)
+	}
+	if p, ok := o.Get(); !ok || p != NewPair("b", 2) {
+		t.Fatalf("json.Unmarshal: got (v=%v, ok=%v), want ((b, 2), true)", p, ok)
+	}
+
+	for _, data := range []string{`["a"]`, `["a",1,2]`, `{"First":"a"}`, `[1,1]`, `["a","b"]`} {
+		var p Pair[string, int]
+		if err := json.Unmarshal([]byte(data), &p); err == nil {
+			t.Fatalf("json.Unmarshal(%s): expected error", data)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package optional
+
+import (
+	"reflect"
+
+	"github.com/Palladium-blockchain/go-optional/internal/textconv"
+)
+
+// Parse parses s into an Optional[T] the same way UnmarshalText does, so
+// environment, flag and query string layers can share one parsing path.
+// EmptyText yields an empty Optional. Strings, integers, floats, booleans,
+// durations, URLs, times in RFC 3339 format and types implementing
+// encoding.TextUnmarshaler are supported, as are types registered with
+// RegisterParser.
+func Parse[T any](s string) (Optional[T], error) {
+	var o Optional[T]
+	if err := o.UnmarshalText([]byte(s)); err != nil {
+		return Optional[T]{}, err
+	}
+	return o, nil
+}
+
+// RegisterParser makes parse the parser for values of type T. It is used
+// by Parse and everywhere else text is parsed into an Optional: its
+// UnmarshalText, flags, FromEnv, CSV cells and XML attributes, and by the
+// optenv package. A registered parser takes precedence over the built-in
+// ones, and a later registration for the same type replaces it.
+//
+// RegisterParser is meant to be called from init functions.
+func RegisterParser[T any](parse func(s string) (T, error)) {
+	if parse == nil {
+		panic("optional: RegisterParser called with nil parser")
+	}
+	textconv.Register(reflect.TypeFor[T](), func(s string) (any, error) {
+		return parse(s)
+	})
+}
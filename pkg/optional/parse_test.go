@@ -0,0 +1,82 @@
+package optional
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	if v, err := Parse[int]("42"); err != nil || v != New(42) {
+		t.Fatalf("Parse[int]: got (%v, %v), want (Some(42), nil)", v, err)
+	}
+	if v, err := Parse[float64]("2.5"); err != nil || v != New(2.5) {
+		t.Fatalf("Parse[float64]: got (%v, %v), want (Some(2.5), nil)", v, err)
+	}
+	if v, err := Parse[bool]("true"); err != nil || v != New(true) {
+		t.Fatalf("Parse[bool]: got (%v, %v), want (Some(true), nil)", v, err)
+	}
+	if v, err := Parse[time.Duration]("1m30s"); err != nil || v != New(90*time.Second) {
+		t.Fatalf("Parse[time.Duration]: got (%v, %v), want (Some(1m30s), nil)", v, err)
+	}
+
+	want := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	if v, err := Parse[time.Time]("2024-05-01T12:00:00Z"); err != nil || !v.MustGet().Equal(want) {
+		t.Fatalf("Parse[time.Time]: got (%v, %v), want (Some(%v), nil)", v, err, want)
+	}
+	if v, err := Parse[url.URL]("https://example.com/x"); err != nil || v.MustGet().Host != "example.com" {
+		t.Fatalf("Parse[url.URL]: got (%v, %v), want host example.com", v, err)
+	}
+
+	if v, err := Parse[int](""); err != nil || !v.IsEmpty() {
+		t.Fatalf("Parse[int](\"\"): got (%v, %v), want (None, nil)", v, err)
+	}
+	if v, err := Parse[int]("x"); err == nil || !v.IsEmpty() {
+		t.Fatalf("Parse[int](\"x\"): got (%v, %v), want an error and None", v, err)
+	}
+}
+
+type parseLevel int
+
+var errUnknownLevel = errors.New("unknown level")
+
+func TestRegisterParser(t *testing.T) {
+	RegisterParser(func(s string) (parseLevel, error) {
+		switch strings.ToLower(s) {
+		case "low":
+			return 1, nil
+		case "high":
+			return 2, nil
+		}
+		return 0, errUnknownLevel
+	})
+
+	if v, err := Parse[parseLevel]("HIGH"); err != nil || v != New[parseLevel](2) {
+		t.Fatalf("Parse: got (%v, %v), want (Some(2), nil)", v, err)
+	}
+	if _, err := Parse[parseLevel]("2"); !errors.Is(err, errUnknownLevel) {
+		t.Fatalf("Parse: got error %v, want %v", err, errUnknownLevel)
+	}
+
+	// The registered parser is shared with UnmarshalText and FromEnv.
+	var o Optional[parseLevel]
+	if err := o.UnmarshalText([]byte("low")); err != nil || o != New[parseLevel](1) {
+		t.Fatalf("UnmarshalText: got (%v, %v), want (Some(1), nil)", o, err)
+	}
+	t.Setenv("PARSE_TEST_LEVEL", "high")
+	var cfg struct {
+		Level Optional[parseLevel] `env:"PARSE_TEST_LEVEL"`
+	}
+	if err := FromEnv(&cfg); err != nil || cfg.Level != New[parseLevel](2) {
+		t.Fatalf("FromEnv: got (%v, %v), want (Some(2), nil)", cfg.Level, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("RegisterParser(nil): expected panic")
+		}
+	}()
+	RegisterParser[parseLevel](nil)
+}
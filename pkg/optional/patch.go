@@ -0,0 +1,88 @@
+package optional
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ApplyPatch copies the present Optional fields of patch onto the fields
+// with the same name in dst, leaving every other field of dst untouched.
+// dst must be a non-nil pointer to a struct; patch is a struct or a pointer
+// to one. Fields of patch that are not Optionals or pointers to Optionals
+// are ignored; a nil *Optional field counts as empty.
+//
+// A present value is stored into a dst field of type T, *T (as a pointer to
+// a copy) or Optional[T]. If the value is a struct and the dst field is a
+// struct of a different type, the value is applied as a nested patch.
+func ApplyPatch(dst any, patch any) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("optional: ApplyPatch: dst must be a non-nil pointer to a struct, got %T", dst)
+	}
+	pv := reflect.ValueOf(patch)
+	if pv.Kind() == reflect.Pointer {
+		if pv.IsNil() {
+			return nil
+		}
+		pv = pv.Elem()
+	}
+	if pv.Kind() != reflect.Struct {
+		return fmt.Errorf("optional: ApplyPatch: patch must be a struct, got %T", patch)
+	}
+	return applyPatch(dv.Elem(), pv, "")
+}
+
+func applyPatch(dst, patch reflect.Value, path string) error {
+	pt := patch.Type()
+	for i := range pt.NumField() {
+		field := pt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		v, ok, isOptional := optionalValue(patch.Field(i))
+		if !isOptional || !ok {
+			continue
+		}
+
+		name := path + field.Name
+		df, found := dst.Type().FieldByName(field.Name)
+		if !found || !df.IsExported() {
+			return fmt.Errorf("optional: ApplyPatch: dst has no field %s", name)
+		}
+		if err := assignPatchValue(dst.FieldByIndex(df.Index), reflect.ValueOf(v), name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func assignPatchValue(dst, v reflect.Value, name string) error {
+	dt := dst.Type()
+	switch {
+	case !v.IsValid():
+		// A present Optional holding a nil interface.
+		if isOptionalType(dt) {
+			dst.Addr().Interface().(anyOptionalSetter).setAnyValue(nil)
+		} else {
+			dst.SetZero()
+		}
+		return nil
+	case v.Type().AssignableTo(dt):
+		dst.Set(v)
+		return nil
+	case dt.Kind() == reflect.Pointer && v.Type().AssignableTo(dt.Elem()):
+		p := reflect.New(dt.Elem())
+		p.Elem().Set(v)
+		dst.Set(p)
+		return nil
+	case isOptionalType(dt):
+		elem := reflect.Zero(dt).Interface().(anyOptional).valueType()
+		if v.Type().AssignableTo(elem) {
+			dst.Addr().Interface().(anyOptionalSetter).setAnyValue(v.Interface())
+			return nil
+		}
+	case v.Kind() == reflect.Struct && dt.Kind() == reflect.Struct:
+		return applyPatch(dst, v, name+".")
+	}
+	return fmt.Errorf("optional: ApplyPatch: cannot assign %s to field %s of type %s", v.Type(), name, dt)
+}
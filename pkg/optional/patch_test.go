@@ -0,0 +1,162 @@
+package optional
+
+import (
+	"strings"
+	"testing"
+)
+
+type patchAddress struct {
+	City string
+	Zip  string
+}
+
+type patchUser struct {
+	Name     string
+	Age      int
+	Nickname *string
+	Email    Optional[string]
+	Address  patchAddress
+	Tags     []string
+	internal int
+}
+
+type patchAddressUpdate struct {
+	City Optional[string]
+	Zip  Optional[string]
+}
+
+type patchUserUpdate struct {
+	Name     Optional[string]
+	Age      Optional[int]
+	Nickname Optional[string]
+	Email    Optional[string]
+	Address  Optional[patchAddressUpdate]
+	Tags     Optional[[]string]
+	Ignored  string
+}
+
+func TestApplyPatch(t *testing.T) {
+	user := patchUser{
+		Name:    "alice",
+		Age:     30,
+		Address: patchAddress{City: "Paris", Zip: "75001"},
+		Tags:    []string{"a"},
+	}
+	patch := patchUserUpdate{
+		Age:      New(31),
+		Nickname: New("al"),
+		Email:    New("alice@example.com"),
+		Address:  New(patchAddressUpdate{City: New("Lyon")}),
+		Ignored:  "x",
+	}
+
+	if err := ApplyPatch(&user, patch); err != nil {
+		t.Fatalf("ApplyPatch: unexpected error: %v", err)
+	}
+
+	if user.Name != "alice" || user.Age != 31 {
+		t.Fatalf("ApplyPatch: got Name=%q Age=%d, want Name=\"alice\" Age=31", user.Name, user.Age)
+	}
+	if user.Nickname == nil || *user.Nickname != "al" {
+		t.Fatalf("ApplyPatch: got Nickname=%v, want pointer to \"al\"", user.Nickname)
+	}
+	if v, ok := user.Email.Get(); !ok || v != "alice@example.com" {
+		t.Fatalf("ApplyPatch: got Email=(v=%q, ok=%v), want (\"alice@example.com\", true)", v, ok)
+	}
+	if user.Address != (patchAddress{City: "Lyon", Zip: "75001"}) {
+		t.Fatalf("ApplyPatch: got Address=%+v, want nested patch applied", user.Address)
+	}
+	if len(user.Tags) != 1 {
+		t.Fatalf("ApplyPatch: empty Tags should leave the field untouched, got %v", user.Tags)
+	}
+}
+
+func TestApplyPatchPointerPatch(t *testing.T) {
+	var user patchUser
+	if err := ApplyPatch(&user, &patchUserUpdate{Name: New("bob")}); err != nil {
+		t.Fatalf("ApplyPatch: unexpected error: %v", err)
+	}
+	if user.Name != "bob" {
+		t.Fatalf("ApplyPatch: got Name=%q, want \"bob\"", user.Name)
+	}
+	if err := ApplyPatch(&user, (*patchUserUpdate)(nil)); err != nil {
+		t.Fatalf("ApplyPatch(nil patch): unexpected error: %v", err)
+	}
+}
+
+func TestApplyPatchPointerFields(t *testing.T) {
+	type update struct {
+		Name *Optional[string]
+		Age  Optional[int]
+	}
+	user := patchUser{Name: "alice"}
+	if err := ApplyPatch(&user, update{Age: New(3)}); err != nil {
+		t.Fatalf("ApplyPatch: unexpected error: %v", err)
+	}
+	if user.Name != "alice" || user.Age != 3 {
+		t.Fatalf("ApplyPatch with nil *Optional: got (Name=%q, Age=%d), want (\"alice\", 3)", user.Name, user.Age)
+	}
+
+	name := New("bob")
+	if err := ApplyPatch(&user, update{Name: &name}); err != nil {
+		t.Fatalf("ApplyPatch: unexpected error: %v", err)
+	}
+	if user.Name != "bob" {
+		t.Fatalf("ApplyPatch with non-nil *Optional: got Name=%q, want \"bob\"", user.Name)
+	}
+}
+
+type badCity struct {
+	City Optional[int]
+}
+
+func TestApplyPatchErrors(t *testing.T) {
+	var user patchUser
+	cases := []struct {
+		name  string
+		dst   any
+		patch any
+		want  string
+	}{
+		{name: "non-pointer dst", dst: user, patch: patchUserUpdate{}, want: "dst must be"},
+		{name: "nil dst", dst: (*patchUser)(nil), patch: patchUserUpdate{}, want: "dst must be"},
+		{name: "non-struct patch", dst: &user, patch: 1, want: "patch must be"},
+		{
+			name:  "missing field",
+			dst:   &user,
+			patch: struct{ Missing Optional[int] }{Missing: New(1)},
+			want:  "dst has no field Missing",
+		},
+		{
+			name:  "unexported field",
+			dst:   &user,
+			patch: struct{ internal Optional[int] }{internal: New(1)},
+		},
+		{
+			name:  "type mismatch",
+			dst:   &user,
+			patch: struct{ Age Optional[string] }{Age: New("x")},
+			want:  "cannot assign string to field Age of type int",
+		},
+		{
+			name:  "nested mismatch",
+			dst:   &user,
+			patch: struct{ Address Optional[badCity] }{Address: New(badCity{City: New(1)})},
+			want:  "field Address.City",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ApplyPatch(tc.dst, tc.patch)
+			if tc.want == "" {
+				if err != nil {
+					t.Fatalf("ApplyPatch: unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("ApplyPatch: got error %v, want it to contain %q", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package optional
+
+import (
+	"bytes"
+
+	"github.com/Palladium-blockchain/go-optional/internal/textconv"
+)
+
+// EmptyPolicy decides how WithPolicy encodes an empty Optional to JSON.
+type EmptyPolicy interface {
+	// EmptyJSON returns the JSON literal written for an empty value, or nil
+	// to omit the field altogether.
+	EmptyJSON() []byte
+}
+
+// EmptyTextPolicy is implemented by policies that also choose the text form
+// of an empty value for WithPolicy's MarshalText, AppendText and
+// UnmarshalText. Policies without it use EmptyText.
+type EmptyTextPolicy interface {
+	EmptyText() string
+}
+
+// EmptyAsNull encodes empty optionals as JSON null, like Optional itself.
+type EmptyAsNull struct{}
+
+func (EmptyAsNull) EmptyJSON() []byte { return []byte("null") }
+
+// EmptyOmitted leaves empty optionals out of the encoded object. The field
+// must be tagged with omitzero; without it, null is written instead.
+type EmptyOmitted struct{}
+
+func (EmptyOmitted) EmptyJSON() []byte { return nil }
+
+// WithPolicy is an Optional whose empty JSON encoding is chosen by the
+// policy P, so each field can match the shape its backend expects:
+//
+//	type Request struct {
+//		Name optional.WithPolicy[string, optional.EmptyOmitted] `json:"name,omitzero"`
+//		Note optional.WithPolicy[string, EmptyString]          `json:"note"`
+//	}
+//
+// where EmptyString is a caller-defined policy returning []byte(`""`).
+// When decoding, the policy's literal is read back as an empty Optional.
+type WithPolicy[T any, P EmptyPolicy] struct {
+	Optional[T]
+}
+
+// IsZero reports whether the value is empty and P omits empty values.
+func (o WithPolicy[T, P]) IsZero() bool {
+	var p P
+	return !o.hasValue && p.EmptyJSON() == nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o WithPolicy[T, P]) MarshalJSON() ([]byte, error) {
+	if !o.hasValue {
+		var p P
+		if literal := p.EmptyJSON(); literal != nil {
+			return literal, nil
+		}
+		return []byte("null"), nil
+	}
+	return o.Optional.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Both null and the policy's literal unset the optional.
+func (o *WithPolicy[T, P]) UnmarshalJSON(data []byte) error {
+	var p P
+	if literal := p.EmptyJSON(); literal != nil && bytes.Equal(bytes.TrimSpace(data), literal) {
+		o.Unset()
+		return nil
+	}
+	return o.Optional.UnmarshalJSON(data)
+}
+
+// MarshalText implements encoding.TextMarshaler. An empty value is encoded
+// as P's EmptyText if P implements EmptyTextPolicy, and as EmptyText
+// otherwise.
+func (o WithPolicy[T, P]) MarshalText() ([]byte, error) {
+	return o.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+func (o WithPolicy[T, P]) AppendText(dst []byte) ([]byte, error) {
+	if !o.hasValue {
+		return append(dst, policyEmptyText[P]()...), nil
+	}
+	return o.Optional.AppendText(dst)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. P's empty text unsets
+// the optional; any other text, including EmptyText when P chooses a
+// different token, is parsed into T.
+func (o *WithPolicy[T, P]) UnmarshalText(text []byte) error {
+	if string(text) == policyEmptyText[P]() {
+		o.Unset()
+		return nil
+	}
+	var v T
+	if err := textconv.Parse(&v, string(text)); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+func policyEmptyText[P EmptyPolicy]() string {
+	var p P
+	if tp, ok := any(p).(EmptyTextPolicy); ok {
+		return tp.EmptyText()
+	}
+	return EmptyText
+}
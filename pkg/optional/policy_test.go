@@ -0,0 +1,78 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type emptyStringPolicy struct{}
+
+func (emptyStringPolicy) EmptyJSON() []byte { return []byte(`""`) }
+
+type policyRequest struct {
+	Null    WithPolicy[int, EmptyAsNull]          `json:"null"`
+	Omitted WithPolicy[int, EmptyOmitted]         `json:"omitted,omitzero"`
+	Custom  WithPolicy[string, emptyStringPolicy] `json:"custom"`
+}
+
+func TestWithPolicyMarshalEmpty(t *testing.T) {
+	got, err := json.Marshal(policyRequest{})
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `{"null":null,"custom":""}`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+}
+
+func TestWithPolicyMarshalPresent(t *testing.T) {
+	var r policyRequest
+	r.Null.Set(1)
+	r.Omitted.Set(0)
+	r.Custom.Set("x")
+
+	got, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `{"null":1,"omitted":0,"custom":"x"}`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+}
+
+func TestWithPolicyOmittedWithoutTagWritesNull(t *testing.T) {
+	got, err := json.Marshal(struct {
+		V WithPolicy[int, EmptyOmitted] `json:"v"`
+	}{})
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `{"v":null}`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+}
+
+func TestWithPolicyUnmarshal(t *testing.T) {
+	var r policyRequest
+	r.Omitted.Set(5)
+	if err := json.Unmarshal([]byte(`{"null":2,"omitted":null,"custom":""}`), &r); err != nil {
+		t.Fatalf("json.Unmarshal: unexpected error: %v", err)
+	}
+
+	if v, ok := r.Null.Get(); !ok || v != 2 {
+		t.Fatalf("Null: got (v=%v, ok=%v), want (2, true)", v, ok)
+	}
+	if !r.Omitted.IsEmpty() {
+		t.Fatalf("Omitted: null should unset the Optional")
+	}
+	if !r.Custom.IsEmpty() {
+		t.Fatalf("Custom: the policy literal should decode as empty")
+	}
+
+	if err := json.Unmarshal([]byte(`{"custom":"y"}`), &r); err != nil {
+		t.Fatalf("json.Unmarshal: unexpected error: %v", err)
+	}
+	if v, ok := r.Custom.Get(); !ok || v != "y" {
+		t.Fatalf("Custom: got (v=%q, ok=%v), want (\"y\", true)", v, ok)
+	}
+}
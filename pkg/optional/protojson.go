@@ -0,0 +1,196 @@
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+
+// MarshalProtoJSON encodes v the way protojson renders the equivalent proto3
+// message, so REST responses built from Optional structs match the output of
+// gRPC-Gateway:
+//
+//   - empty Optional fields, and nil *Optional fields, are omitted, like
+//     unset proto3 optional fields;
+//   - other fields holding their zero value are omitted, like proto3
+//     implicit-presence fields;
+//   - 64-bit integers are encoded as JSON strings;
+//   - NaN and infinities are encoded as "NaN", "Infinity" and "-Infinity";
+//   - field names are taken from the json= option of a protobuf struct tag,
+//     otherwise from the json tag or Go field name converted to lowerCamelCase.
+//
+// Types implementing json.Marshaler are encoded with it.
+func MarshalProtoJSON(v any) ([]byte, error) {
+	return appendProtoJSON(nil, reflect.ValueOf(v))
+}
+
+func appendProtoJSON(dst []byte, rv reflect.Value) ([]byte, error) {
+	if !rv.IsValid() {
+		return append(dst, "null"...), nil
+	}
+	if isOptionalType(rv.Type()) {
+		v, ok := rv.Interface().(anyOptional).anyValue()
+		if !ok {
+			return append(dst, "null"...), nil
+		}
+		return appendProtoJSON(dst, reflect.ValueOf(v))
+	}
+	if rv.Type().Implements(jsonMarshalerType) && (rv.Kind() != reflect.Pointer || !rv.IsNil()) {
+		b, err := json.Marshal(rv.Interface())
+		return append(dst, b...), err
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return append(dst, "null"...), nil
+		}
+		return appendProtoJSON(dst, rv.Elem())
+	case reflect.Struct:
+		return appendProtoJSONStruct(dst, rv)
+	case reflect.Map:
+		return appendProtoJSONMap(dst, rv)
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := json.Marshal(rv.Interface())
+			return append(dst, b...), err
+		}
+		if rv.IsNil() {
+			return append(dst, "[]"...), nil
+		}
+		fallthrough
+	case reflect.Array:
+		dst = append(dst, '[')
+		for i := range rv.Len() {
+			if i > 0 {
+				dst = append(dst, ',')
+			}
+			var err error
+			if dst, err = appendProtoJSON(dst, rv.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return append(dst, ']'), nil
+	case reflect.Int64:
+		return strconv.AppendQuote(dst, strconv.FormatInt(rv.Int(), 10)), nil
+	case reflect.Uint64:
+		return strconv.AppendQuote(dst, strconv.FormatUint(rv.Uint(), 10)), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		switch {
+		case math.IsNaN(f):
+			return append(dst, `"NaN"`...), nil
+		case math.IsInf(f, 1):
+			return append(dst, `"Infinity"`...), nil
+		case math.IsInf(f, -1):
+			return append(dst, `"-Infinity"`...), nil
+		}
+	}
+	b, err := json.Marshal(rv.Interface())
+	return append(dst, b...), err
+}
+
+func appendProtoJSONStruct(dst []byte, rv reflect.Value) ([]byte, error) {
+	dst = append(dst, '{')
+	first := true
+	for i := range rv.NumField() {
+		field := rv.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, ok := protoJSONName(field)
+		if !ok {
+			continue
+		}
+		fv := rv.Field(i)
+		if _, present, isOptional := optionalValue(fv); isOptional {
+			if !present {
+				continue
+			}
+		} else if fv.IsZero() {
+			continue
+		}
+
+		if !first {
+			dst = append(dst, ',')
+		}
+		first = false
+		dst = strconv.AppendQuote(dst, name)
+		dst = append(dst, ':')
+		var err error
+		if dst, err = appendProtoJSON(dst, fv); err != nil {
+			return nil, err
+		}
+	}
+	return append(dst, '}'), nil
+}
+
+func appendProtoJSONMap(dst []byte, rv reflect.Value) ([]byte, error) {
+	keys := make([]string, 0, rv.Len())
+	values := make(map[string]reflect.Value, rv.Len())
+	for iter := rv.MapRange(); iter.Next(); {
+		key := fmt.Sprint(iter.Key().Interface())
+		keys = append(keys, key)
+		values[key] = iter.Value()
+	}
+	slices.Sort(keys)
+
+	dst = append(dst, '{')
+	for i, key := range keys {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		quoted, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, quoted...)
+		dst = append(dst, ':')
+		if dst, err = appendProtoJSON(dst, values[key]); err != nil {
+			return nil, err
+		}
+	}
+	return append(dst, '}'), nil
+}
+
+// protoJSONName returns the protojson field name for field, or false if the
+// field is excluded with json:"-".
+func protoJSONName(field reflect.StructField) (string, bool) {
+	for _, opt := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(opt, "json="); ok {
+			return name, true
+		}
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = strings.ToLower(field.Name[:1]) + field.Name[1:]
+	}
+	return jsonCamelCase(name), true
+}
+
+// jsonCamelCase converts a snake_case name to lowerCamelCase following the
+// protobuf JSON mapping.
+func jsonCamelCase(s string) string {
+	var b strings.Builder
+	wasUnderscore := false
+	for i := range len(s) {
+		c := s[i]
+		if c != '_' {
+			if wasUnderscore && 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b.WriteByte(c)
+		}
+		wasUnderscore = c == '_'
+	}
+	return b.String()
+}
@@ -0,0 +1,99 @@
+package optional
+
+import (
+	"math"
+	"testing"
+)
+
+type protoJSONAddress struct {
+	City Optional[string] `json:"city"`
+}
+
+type protoJSONUser struct {
+	UserID      Optional[int64]   `json:"user_id"`
+	DisplayName Optional[string]  `json:"display_name"`
+	Age         Optional[int32]   `json:"age"`
+	Score       Optional[float64] `json:"score"`
+	Tags        []string          `json:"tags"`
+	Active      bool              `json:"active"`
+	Address     *protoJSONAddress `json:"address"`
+	Labels      map[string]Optional[string]
+	Secret      string           `json:"-"`
+	Legacy      Optional[string] `protobuf:"bytes,9,opt,name=legacy_name,json=legacyName,proto3" json:"legacy_name,omitempty"`
+}
+
+func TestMarshalProtoJSON(t *testing.T) {
+	age := New[int32](3)
+	cases := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{name: "all unset", in: protoJSONUser{}, want: `{}`},
+		{
+			name: "present zero values are kept",
+			in:   protoJSONUser{Age: New[int32](0), DisplayName: New("")},
+			want: `{"displayName":"","age":0}`,
+		},
+		{
+			name: "int64 as string",
+			in:   protoJSONUser{UserID: New[int64](9007199254740993)},
+			want: `{"userId":"9007199254740993"}`,
+		},
+		{
+			name: "non-finite floats",
+			in:   []Optional[float64]{New(math.NaN()), New(math.Inf(1)), New(math.Inf(-1)), New(1.5)},
+			want: `["NaN","Infinity","-Infinity",1.5]`,
+		},
+		{
+			name: "nested and collections",
+			in: protoJSONUser{
+				Tags:    []string{"a"},
+				Active:  true,
+				Address: &protoJSONAddress{City: New("Paris")},
+				Labels:  map[string]Optional[string]{"b": New("2"), "a": Empty[string]()},
+				Secret:  "s",
+				Legacy:  New("x"),
+			},
+			want: `{"tags":["a"],"active":true,"address":{"city":"Paris"},"labels":{"a":null,"b":"2"},"legacyName":"x"}`,
+		},
+		{
+			name: "pointer to optional",
+			in: struct {
+				Nick *Optional[string] `json:"nick"`
+				Age  *Optional[int32]  `json:"age"`
+			}{Age: &age},
+			want: `{"age":3}`,
+		},
+		{name: "nil pointers to optional", in: []*Optional[int]{nil}, want: `[null]`},
+		{name: "top-level empty", in: Empty[int](), want: `null`},
+		{name: "top-level present", in: New[uint64](1), want: `"1"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := MarshalProtoJSON(tc.in)
+			if err != nil {
+				t.Fatalf("MarshalProtoJSON: unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("MarshalProtoJSON: got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJSONCamelCase(t *testing.T) {
+	cases := map[string]string{
+		"user_id":     "userId",
+		"userId":      "userId",
+		"a_b_c":       "aBC",
+		"foo__bar":    "fooBar",
+		"field_1_two": "field1Two",
+	}
+	for in, want := range cases {
+		if got := jsonCamelCase(in); got != want {
+			t.Fatalf("jsonCamelCase(%q): got %q, want %q", in, got, want)
+		}
+	}
+}
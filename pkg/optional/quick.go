@@ -0,0 +1,130 @@
+package optional
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+)
+
+// quickGenerator mirrors quick.Generator. This file does not import
+// testing/quick, which would register its -quickchecks flag in every
+// program using this package.
+type quickGenerator interface {
+	Generate(rand *rand.Rand, size int) reflect.Value
+}
+
+var quickGeneratorType = reflect.TypeFor[quickGenerator]()
+
+// Generate implements quick.Generator, so testing/quick can build random
+// Optionals and structs holding them. Half of the generated Optionals are
+// empty; the others hold a random T, produced by T's own Generate method
+// if it has one and otherwise the way testing/quick builds values of T's
+// kind. Types testing/quick cannot generate, such as channels, functions
+// and structs with unexported fields, always yield an empty Optional.
+func (o Optional[T]) Generate(rand *rand.Rand, size int) reflect.Value {
+	var out Optional[T]
+	if rand.Intn(2) == 1 {
+		if v, ok := quickValue(reflect.TypeFor[T](), rand, size); ok {
+			out.Set(v.Interface().(T))
+		}
+	}
+	return reflect.ValueOf(out)
+}
+
+// quickValue returns a random value of type t, following the algorithm of
+// quick.Value.
+func quickValue(t reflect.Type, rand *rand.Rand, size int) (reflect.Value, bool) {
+	if t.Implements(quickGeneratorType) {
+		return reflect.Zero(t).Interface().(quickGenerator).Generate(rand, size), true
+	}
+	if size < 1 {
+		size = 1
+	}
+
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(rand.Int()&1 == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := rand.Int63()
+		if rand.Int()&1 == 1 {
+			n = -n
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(uint64(rand.Int63()) | uint64(rand.Int63())<<63)
+	case reflect.Float32:
+		v.SetFloat(quickFloat(rand, math.MaxFloat32))
+	case reflect.Float64:
+		v.SetFloat(quickFloat(rand, math.MaxFloat64))
+	case reflect.Complex64:
+		v.SetComplex(complex(quickFloat(rand, math.MaxFloat32), quickFloat(rand, math.MaxFloat32)))
+	case reflect.Complex128:
+		v.SetComplex(complex(quickFloat(rand, math.MaxFloat64), quickFloat(rand, math.MaxFloat64)))
+	case reflect.String:
+		runes := make([]rune, rand.Intn(size))
+		for i := range runes {
+			runes[i] = rune(rand.Intn(0x10ffff))
+		}
+		v.SetString(string(runes))
+	case reflect.Pointer:
+		if rand.Intn(size) == 0 {
+			break
+		}
+		elem, ok := quickValue(t.Elem(), rand, size)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(elem)
+		v.Set(p)
+	case reflect.Slice:
+		n := rand.Intn(size)
+		v.Set(reflect.MakeSlice(t, n, n))
+		fallthrough
+	case reflect.Array:
+		for i := range v.Len() {
+			elem, ok := quickValue(t.Elem(), rand, size)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			v.Index(i).Set(elem)
+		}
+	case reflect.Map:
+		n := rand.Intn(size)
+		v.Set(reflect.MakeMapWithSize(t, n))
+		for range n {
+			key, ok := quickValue(t.Key(), rand, size)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			elem, ok := quickValue(t.Elem(), rand, size)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			v.SetMapIndex(key, elem)
+		}
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if !t.Field(i).IsExported() {
+				return reflect.Value{}, false
+			}
+			elem, ok := quickValue(t.Field(i).Type, rand, size)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			v.Field(i).Set(elem)
+		}
+	default:
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+func quickFloat(rand *rand.Rand, limit float64) float64 {
+	f := rand.Float64() * limit
+	if rand.Int()&1 == 1 {
+		f = -f
+	}
+	return f
+}
@@ -0,0 +1,85 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+var _ quick.Generator = Optional[int]{}
+
+type quickRecord struct {
+	Name  string
+	Age   Optional[int]
+	Tags  Optional[[]string]
+	Score Optional[float64]
+	Child Optional[*quickChild]
+}
+
+type quickChild struct {
+	ID Optional[uint16]
+}
+
+type quickOpaque struct {
+	hidden int
+}
+
+func TestGenerateMixesEmptyAndPresent(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var empty, present int
+	for range 200 {
+		o := Optional[int32]{}.Generate(r, 10).Interface().(Optional[int32])
+		if o.IsEmpty() {
+			empty++
+		} else {
+			present++
+		}
+	}
+	if empty == 0 || present == 0 {
+		t.Fatalf("Generate: got %d empty and %d present, want both", empty, present)
+	}
+}
+
+func TestGenerateUnsupportedIsEmpty(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 20 {
+		if o := (Optional[quickOpaque]{}).Generate(r, 10).Interface().(Optional[quickOpaque]); !o.IsEmpty() {
+			t.Fatalf("Generate: got %v, want empty for a struct with unexported fields", o)
+		}
+		if o := (Optional[chan int]{}).Generate(r, 10).Interface().(Optional[chan int]); !o.IsEmpty() {
+			t.Fatalf("Generate: got %v, want empty for a channel", o)
+		}
+	}
+}
+
+func TestQuickCheckJSONRoundTrip(t *testing.T) {
+	roundTrip := func(in quickRecord) bool {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return false
+		}
+		var out quickRecord
+		if err := json.Unmarshal(data, &out); err != nil {
+			return false
+		}
+		again, err := json.Marshal(out)
+		return err == nil && bytes.Equal(data, again)
+	}
+	cfg := &quick.Config{Rand: rand.New(rand.NewSource(1))}
+	if err := quick.Check(roundTrip, cfg); err != nil {
+		t.Fatalf("quick.Check: %v", err)
+	}
+}
+
+func TestQuickValue(t *testing.T) {
+	v, ok := quick.Value(reflect.TypeFor[quickRecord](), rand.New(rand.NewSource(2)))
+	if !ok {
+		t.Fatalf("quick.Value: could not generate a struct with Optional fields")
+	}
+	if _, ok := v.Interface().(quickRecord); !ok {
+		t.Fatalf("quick.Value: got %T, want quickRecord", v.Interface())
+	}
+}
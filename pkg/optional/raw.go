@@ -0,0 +1,21 @@
+package optional
+
+import "encoding/json"
+
+// Raw captures the raw bytes of a JSON field together with its presence,
+// so decoding of large or rarely needed fields can be deferred with
+// DecodeRaw. JSON null and missing fields both leave it empty.
+type Raw = Optional[json.RawMessage]
+
+// DecodeRaw decodes the captured JSON into T.
+// An empty Raw yields an empty Optional without error.
+func DecodeRaw[T any](r Raw) (Optional[T], error) {
+	if !r.hasValue {
+		return Optional[T]{}, nil
+	}
+	var v T
+	if err := json.Unmarshal(r.value, &v); err != nil {
+		return Optional[T]{}, err
+	}
+	return New(v), nil
+}
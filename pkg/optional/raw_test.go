@@ -0,0 +1,64 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type rawEnvelope struct {
+	Kind    string `json:"kind"`
+	Payload Raw    `json:"payload"`
+}
+
+func TestRawCapturesBytes(t *testing.T) {
+	var e rawEnvelope
+	if err := json.Unmarshal([]byte(`{"kind":"point","payload":{"x": 1, "y": 2}}`), &e); err != nil {
+		t.Fatalf("json.Unmarshal: unexpected error: %v", err)
+	}
+
+	raw, ok := e.Payload.Get()
+	if !ok || string(raw) != `{"x": 1, "y": 2}` {
+		t.Fatalf("Payload: got (v=%s, ok=%v), want raw object", raw, ok)
+	}
+
+	type point struct{ X, Y int }
+	p, err := DecodeRaw[point](e.Payload)
+	if err != nil {
+		t.Fatalf("DecodeRaw: unexpected error: %v", err)
+	}
+	if v, ok := p.Get(); !ok || v != (point{X: 1, Y: 2}) {
+		t.Fatalf("DecodeRaw: got (v=%+v, ok=%v), want ({X:1 Y:2}, true)", v, ok)
+	}
+}
+
+func TestRawNullAndMissing(t *testing.T) {
+	for _, input := range []string{`{"kind":"k"}`, `{"kind":"k","payload":null}`} {
+		var e rawEnvelope
+		if err := json.Unmarshal([]byte(input), &e); err != nil {
+			t.Fatalf("json.Unmarshal(%s): unexpected error: %v", input, err)
+		}
+		if !e.Payload.IsEmpty() {
+			t.Fatalf("Payload for %s should be empty", input)
+		}
+		o, err := DecodeRaw[int](e.Payload)
+		if err != nil || !o.IsEmpty() {
+			t.Fatalf("DecodeRaw(empty): got (%v, %v), want (None, nil)", o, err)
+		}
+	}
+}
+
+func TestDecodeRawInvalid(t *testing.T) {
+	if _, err := DecodeRaw[int](New(json.RawMessage(`"x"`))); err == nil {
+		t.Fatalf("DecodeRaw: expected error for mismatched type")
+	}
+}
+
+func TestRawMarshalJSON(t *testing.T) {
+	got, err := json.Marshal(rawEnvelope{Kind: "k", Payload: New(json.RawMessage(`[1, 2]`))})
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `{"kind":"k","payload":[1,2]}`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+}
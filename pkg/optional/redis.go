@@ -0,0 +1,11 @@
+package optional
+
+// ScanRedis implements the Scanner interface that go-redis uses when
+// scanning hash fields into structs, for example with
+// client.HGetAll(ctx, key).Scan(&v). It decodes the MarshalBinary encoding
+// go-redis writes for Optional values; fields missing from the hash are
+// not scanned and so stay empty. See the optredis package for helpers that
+// write hashes and read single values.
+func (o *Optional[T]) ScanRedis(s string) error {
+	return o.UnmarshalBinary([]byte(s))
+}
@@ -0,0 +1,46 @@
+package optional
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisUser struct {
+	Name  string           `redis:"name"`
+	Email Optional[string] `redis:"email"`
+	Age   Optional[int]    `redis:"age"`
+}
+
+func newRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestScanRedis(t *testing.T) {
+	ctx := context.Background()
+	client := newRedis(t)
+
+	if err := client.HSet(ctx, "user", "name", "ann", "age", New(0)).Err(); err != nil {
+		t.Fatalf("HSet: unexpected error: %v", err)
+	}
+	var out redisUser
+	if err := client.HGetAll(ctx, "user").Scan(&out); err != nil {
+		t.Fatalf("Scan: unexpected error: %v", err)
+	}
+	if out.Name != "ann" || !out.Email.IsEmpty() || out.Age != New(0) {
+		t.Fatalf("Scan: got %+v, want {Name:ann Email:None Age:Some(0)}", out)
+	}
+
+	if err := client.Set(ctx, "k", New(42), 0).Err(); err != nil {
+		t.Fatalf("Set: unexpected error: %v", err)
+	}
+	var scanned Optional[int]
+	if err := client.Get(ctx, "k").Scan(&scanned); err != nil || scanned != New(42) {
+		t.Fatalf("Scan: got (%v, %v), want Some(42)", scanned, err)
+	}
+}
@@ -0,0 +1,112 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// Ref is like Optional but holds a pointer to its value, so large values
+// are not copied by New, Get or Or. Copies of a Ref share the same value:
+// changes made through the pointer returned by Get are visible to all of
+// them. Set and Unset only change which value the receiver refers to.
+type Ref[T any] struct {
+	p *T
+}
+
+// NewRef returns a Ref referring to p. A nil p yields an empty Ref.
+func NewRef[T any](p *T) Ref[T] {
+	return Ref[T]{p: p}
+}
+
+// EmptyRef returns an empty Ref.
+func EmptyRef[T any]() Ref[T] {
+	return Ref[T]{}
+}
+
+// ToRef returns a Ref holding a copy of o's value.
+func (o Optional[T]) ToRef() Ref[T] {
+	return NewRef(o.ToPtr())
+}
+
+// Optional returns an Optional holding a copy of the referenced value.
+func (r Ref[T]) Optional() Optional[T] {
+	return FromPtr(r.p)
+}
+
+func (r Ref[T]) IsEmpty() bool {
+	return r.p == nil
+}
+
+// IsZero reports whether the Ref is empty, so encoding/json omits it with
+// omitzero.
+func (r Ref[T]) IsZero() bool {
+	return r.p == nil
+}
+
+// Get returns the shared pointer and whether it is non-nil.
+func (r Ref[T]) Get() (*T, bool) {
+	return r.p, r.p != nil
+}
+
+// MustGet returns the shared pointer or panics if the Ref is empty.
+func (r Ref[T]) MustGet() *T {
+	if r.p == nil {
+		panic("optional: MustGet called on empty Ref[" + reflect.TypeFor[T]().String() + "]")
+	}
+	return r.p
+}
+
+// Or returns the shared pointer, or defaultValue if the Ref is empty.
+func (r Ref[T]) Or(defaultValue *T) *T {
+	if r.p == nil {
+		return defaultValue
+	}
+	return r.p
+}
+
+// OrElseGet is like Or, but supplier is only called when the Ref is empty.
+func (r Ref[T]) OrElseGet(supplier func() *T) *T {
+	if r.p == nil {
+		return supplier()
+	}
+	return r.p
+}
+
+// Set makes the Ref refer to p. A nil p empties it.
+func (r *Ref[T]) Set(p *T) {
+	r.p = p
+}
+
+func (r *Ref[T]) Unset() {
+	r.p = nil
+}
+
+// String implements fmt.Stringer.
+func (r Ref[T]) String() string {
+	return r.Optional().String()
+}
+
+// MarshalJSON implements json.Marshaler.
+// Empty Refs are encoded as JSON null.
+func (r Ref[T]) MarshalJSON() ([]byte, error) {
+	if r.p == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// JSON null empties the Ref; otherwise it decodes into a new value.
+func (r *Ref[T]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		r.p = nil
+		return nil
+	}
+	p := new(T)
+	if err := json.Unmarshal(data, p); err != nil {
+		return err
+	}
+	r.p = p
+	return nil
+}
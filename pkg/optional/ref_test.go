@@ -0,0 +1,101 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRefSharing(t *testing.T) {
+	type big struct{ N int }
+
+	r := NewRef(&big{N: 1})
+	shared := r
+
+	p, ok := r.Get()
+	if !ok {
+		t.Fatalf("Get: expected a value")
+	}
+	p.N = 2
+	if got := shared.MustGet().N; got != 2 {
+		t.Fatalf("MustGet on copy: got %d, want 2", got)
+	}
+
+	r.Unset()
+	if !r.IsEmpty() || shared.IsEmpty() {
+		t.Fatalf("Unset: should only empty the receiver")
+	}
+}
+
+func TestRefConversions(t *testing.T) {
+	o := New(3)
+	r := o.ToRef()
+	*r.MustGet() = 4
+	if v, ok := o.Get(); !ok || v != 3 {
+		t.Fatalf("ToRef: should copy the value, got original %d", v)
+	}
+	if v, ok := r.Optional().Get(); !ok || v != 4 {
+		t.Fatalf("Optional: got (v=%v, ok=%v), want (4, true)", v, ok)
+	}
+
+	if !Empty[int]().ToRef().IsEmpty() || !EmptyRef[int]().Optional().IsEmpty() {
+		t.Fatalf("conversions of empty values should stay empty")
+	}
+	if !NewRef[int](nil).IsEmpty() {
+		t.Fatalf("NewRef(nil): expected empty Ref")
+	}
+}
+
+func TestRefOr(t *testing.T) {
+	def := new(int)
+	if got := EmptyRef[int]().Or(def); got != def {
+		t.Fatalf("Or on empty: should return the default pointer")
+	}
+	if got := EmptyRef[int]().OrElseGet(func() *int { return def }); got != def {
+		t.Fatalf("OrElseGet on empty: should return the supplied pointer")
+	}
+
+	v := 1
+	if got := NewRef(&v).Or(def); got != &v {
+		t.Fatalf("Or on present: should return the shared pointer")
+	}
+}
+
+func TestRefMustGetPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "optional: MustGet called on empty Ref[int]" {
+			t.Fatalf("MustGet: unexpected panic value %v", r)
+		}
+	}()
+	EmptyRef[int]().MustGet()
+}
+
+func TestRefJSON(t *testing.T) {
+	type doc struct {
+		A Ref[string] `json:"a"`
+		B Ref[string] `json:"b"`
+		C Ref[string] `json:"c,omitzero"`
+	}
+
+	s := "x"
+	got, err := json.Marshal(doc{A: NewRef(&s)})
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `{"a":"x","b":null}`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+
+	d := doc{B: NewRef(&s)}
+	if err := json.Unmarshal([]byte(`{"a":"y","b": null }`), &d); err != nil {
+		t.Fatalf("json.Unmarshal: unexpected error: %v", err)
+	}
+	if p, ok := d.A.Get(); !ok || *p != "y" {
+		t.Fatalf("A: got (v=%v, ok=%v), want (\"y\", true)", d.A, ok)
+	}
+	if !d.B.IsEmpty() {
+		t.Fatalf("B: null should empty the Ref")
+	}
+	if s != "x" {
+		t.Fatalf("json.Unmarshal: should not write through a previously shared pointer")
+	}
+}
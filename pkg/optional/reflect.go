@@ -0,0 +1,104 @@
+package optional
+
+import (
+	"reflect"
+
+	"github.com/Palladium-blockchain/go-optional/internal/textconv"
+)
+
+// anyOptional is implemented by every Optional[T]. It lets the
+// reflection-based helpers in this package inspect an Optional without
+// knowing T.
+type anyOptional interface {
+	anyValue() (any, bool)
+	valueType() reflect.Type
+}
+
+// anyOptionalSetter is implemented by every *Optional[T], for helpers that
+// fill in Optionals through reflection.
+type anyOptionalSetter interface {
+	// setAnyValue sets the Optional to v, which must be assignable to T.
+	setAnyValue(v any)
+}
+
+var (
+	anyOptionalType       = reflect.TypeFor[anyOptional]()
+	anyOptionalSetterType = reflect.TypeFor[anyOptionalSetter]()
+)
+
+func (o Optional[T]) anyValue() (any, bool) {
+	return o.value, o.hasValue
+}
+
+func (o Optional[T]) valueType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (o *Optional[T]) setAnyValue(v any) {
+	var value T
+	if v != nil {
+		value = v.(T)
+	}
+	o.Set(value)
+}
+
+// isOptionalType reports whether t is an Optional[T] for some T.
+func isOptionalType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.Implements(anyOptionalType) &&
+		reflect.PointerTo(t).Implements(anyOptionalSetterType)
+}
+
+// optionalValue returns the value held by fv and whether it is present, if
+// fv is an Optional or a pointer to one; isOptional is false for anything
+// else. A nil *Optional counts as an empty Optional.
+func optionalValue(fv reflect.Value) (v any, present, isOptional bool) {
+	t := fv.Type()
+	if t.Kind() == reflect.Pointer && isOptionalType(t.Elem()) {
+		if fv.IsNil() {
+			return nil, false, true
+		}
+		fv = fv.Elem()
+	} else if !isOptionalType(t) {
+		return nil, false, false
+	}
+	v, present = fv.Interface().(anyOptional).anyValue()
+	return v, present, true
+}
+
+// walkOptionalFields calls fn for every exported Optional field of the
+// struct rv, descending into nested and embedded struct fields. name is
+// the dotted path of the field from rv.
+func walkOptionalFields(rv reflect.Value, path string, fn func(field reflect.StructField, fv reflect.Value, name string) error) error {
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		name := path + field.Name
+		switch {
+		case isOptionalType(field.Type):
+			if err := fn(field, fv, name); err != nil {
+				return err
+			}
+		case field.Type.Kind() == reflect.Struct:
+			if err := walkOptionalFields(fv, name+".", fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setOptionalFromText parses s into the value type of the Optional fv and
+// sets it.
+func setOptionalFromText(fv reflect.Value, s string) error {
+	elem := reflect.Zero(fv.Type()).Interface().(anyOptional).valueType()
+	v := reflect.New(elem)
+	if err := textconv.Parse(v.Interface(), s); err != nil {
+		return err
+	}
+	fv.Addr().Interface().(anyOptionalSetter).setAnyValue(v.Elem().Interface())
+	return nil
+}
@@ -0,0 +1,126 @@
+package optional
+
+import "fmt"
+
+// Result holds either a value or an error, so that (T, error) pipelines can
+// be chained the same way as Optional ones. The zero value is Ok with T's
+// zero value.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err returns a failed Result holding err. It panics if err is nil.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("optional: Err called with nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// ResultOf converts a (T, error) pair into a Result. A non-nil err yields a
+// failed Result and value is dropped.
+func ResultOf[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+	return Ok(value)
+}
+
+// ResultFromOptional converts o into a Result, using err when o is empty,
+// or ErrEmpty if err is nil.
+func ResultFromOptional[T any](o Optional[T], err error) Result[T] {
+	if !o.hasValue {
+		if err == nil {
+			err = ErrEmpty
+		}
+		return Err[T](err)
+	}
+	return Ok(o.value)
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and error as a (T, error) pair. The value is T's
+// zero value when the Result failed.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error, or nil if the Result is Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Optional converts the Result into an Optional, dropping the error.
+func (r Result[T]) Optional() Optional[T] {
+	return FromTuple(r.value, r.err)
+}
+
+// Or returns the value, or defaultValue if the Result failed.
+func (r Result[T]) Or(defaultValue T) T {
+	if r.err != nil {
+		return defaultValue
+	}
+	return r.value
+}
+
+// Map applies f to the value of an Ok Result. A failed Result is returned
+// unchanged. Use MapResult to change the value's type.
+func (r Result[T]) Map(f func(T) T) Result[T] {
+	return MapResult(r, f)
+}
+
+// AndThen calls f with the value of an Ok Result and returns its result.
+// A failed Result is returned unchanged.
+func (r Result[T]) AndThen(f func(T) Result[T]) Result[T] {
+	if r.err != nil {
+		return r
+	}
+	return f(r.value)
+}
+
+// OrElse calls f with the error of a failed Result and returns its result,
+// allowing recovery. An Ok Result is returned unchanged.
+func (r Result[T]) OrElse(f func(error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return f(r.err)
+}
+
+// String implements fmt.Stringer.
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return "Err(" + r.err.Error() + ")"
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// MapResult applies f to the value of an Ok Result. The error of a failed
+// Result is carried over.
+func MapResult[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return Ok(f(r.value))
+}
+
+// FlatMapResult is like MapResult, but f itself returns a Result.
+func FlatMapResult[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return f(r.value)
+}
@@ -0,0 +1,106 @@
+package optional
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+var errResult = errors.New("boom")
+
+func TestResultConstructors(t *testing.T) {
+	if v, err := Ok(1).Get(); err != nil || v != 1 {
+		t.Fatalf("Ok: got (v=%v, err=%v), want (1, nil)", v, err)
+	}
+	if v, err := Err[int](errResult).Get(); !errors.Is(err, errResult) || v != 0 {
+		t.Fatalf("Err: got (v=%v, err=%v), want (0, %v)", v, err, errResult)
+	}
+
+	if r := ResultOf(strconv.Atoi("12")); !r.IsOk() || r.Or(0) != 12 {
+		t.Fatalf("ResultOf: got %v, want Ok(12)", r)
+	}
+	if r := ResultOf(strconv.Atoi("x")); !r.IsErr() {
+		t.Fatalf("ResultOf: got %v, want an error", r)
+	}
+
+	if r := ResultFromOptional(New("a"), errResult); !r.IsOk() || r.Or("") != "a" {
+		t.Fatalf("ResultFromOptional: got %v, want Ok(a)", r)
+	}
+	if r := ResultFromOptional(Empty[string](), errResult); !errors.Is(r.Err(), errResult) {
+		t.Fatalf("ResultFromOptional: got %v, want Err(boom)", r)
+	}
+	if r := ResultFromOptional(Empty[string](), nil); !errors.Is(r.Err(), ErrEmpty) {
+		t.Fatalf("ResultFromOptional with nil error: got %v, want Err(%v)", r, ErrEmpty)
+	}
+}
+
+func TestErrNilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Err(nil): expected panic")
+		}
+	}()
+	Err[int](nil)
+}
+
+func TestResultOptional(t *testing.T) {
+	if v, ok := Ok(3).Optional().Get(); !ok || v != 3 {
+		t.Fatalf("Optional: got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+	if !Err[int](errResult).Optional().IsEmpty() {
+		t.Fatalf("Optional: expected empty for a failed Result")
+	}
+}
+
+func TestResultChaining(t *testing.T) {
+	double := func(v int) int { return v * 2 }
+	half := func(v int) Result[int] {
+		if v%2 != 0 {
+			return Err[int](errors.New("odd"))
+		}
+		return Ok(v / 2)
+	}
+	fallback := func(error) Result[int] { return Ok(-1) }
+
+	if got := Ok(3).Map(double).AndThen(half).Or(0); got != 3 {
+		t.Fatalf("Map/AndThen: got %d, want 3", got)
+	}
+	if r := Ok(3).AndThen(half); r.Err() == nil || r.Err().Error() != "odd" {
+		t.Fatalf("AndThen: got %v, want Err(odd)", r)
+	}
+	if r := Err[int](errResult).Map(double).AndThen(half); !errors.Is(r.Err(), errResult) {
+		t.Fatalf("Map/AndThen on Err: got %v, want the original error", r)
+	}
+	if got := Err[int](errResult).OrElse(fallback).Or(0); got != -1 {
+		t.Fatalf("OrElse on Err: got %d, want -1", got)
+	}
+	if got := Ok(5).OrElse(fallback).Or(0); got != 5 {
+		t.Fatalf("OrElse on Ok: got %d, want 5", got)
+	}
+}
+
+func TestMapResult(t *testing.T) {
+	if got := MapResult(Ok(7), strconv.Itoa).Or(""); got != "7" {
+		t.Fatalf("MapResult: got %q, want \"7\"", got)
+	}
+	if r := MapResult(Err[int](errResult), strconv.Itoa); !errors.Is(r.Err(), errResult) {
+		t.Fatalf("MapResult on Err: got %v, want the original error", r)
+	}
+
+	parse := func(s string) Result[int] { return ResultOf(strconv.Atoi(s)) }
+	if got := FlatMapResult(Ok("8"), parse).Or(0); got != 8 {
+		t.Fatalf("FlatMapResult: got %d, want 8", got)
+	}
+	if r := FlatMapResult(Ok("x"), parse); r.IsOk() {
+		t.Fatalf("FlatMapResult: got %v, want an error", r)
+	}
+}
+
+func TestResultString(t *testing.T) {
+	if got := Ok(1).String(); got != "Ok(1)" {
+		t.Fatalf("String: got %q, want \"Ok(1)\"", got)
+	}
+	if got := Err[int](errResult).String(); got != "Err(boom)" {
+		t.Fatalf("String: got %q, want \"Err(boom)\"", got)
+	}
+}
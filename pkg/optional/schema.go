@@ -0,0 +1,191 @@
+package optional
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// schemaValuer is implemented by the wrapper types of this package whose
+// JSON form is either null or the JSON of a value of the returned type.
+type schemaValuer interface {
+	valueType() reflect.Type
+}
+
+var (
+	schemaValuerType  = reflect.TypeFor[schemaValuer]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	timeType          = reflect.TypeFor[time.Time]()
+)
+
+// JSONSchemaAlias returns a zero T. Schema reflectors that honor it, such as
+// github.com/invopop/jsonschema, document the field as T instead of as an
+// object with unexported fields.
+func (o Optional[T]) JSONSchemaAlias() any {
+	var zero T
+	return zero
+}
+
+// JSONSchemaBytes returns the JSON Schema of the Optional as built by
+// JSONSchema. It implements the RawExposer interface of
+// github.com/swaggest/jsonschema-go.
+func (o Optional[T]) JSONSchemaBytes() ([]byte, error) {
+	return json.Marshal(JSONSchema(o))
+}
+
+// JSONSchemaAlias returns a zero T; see Optional.JSONSchemaAlias.
+func (s TriState[T]) JSONSchemaAlias() any {
+	var zero T
+	return zero
+}
+
+// JSONSchemaBytes returns the JSON Schema of the TriState as built by
+// JSONSchema.
+func (s TriState[T]) JSONSchemaBytes() ([]byte, error) {
+	return json.Marshal(JSONSchema(s))
+}
+
+func (s TriState[T]) valueType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (r Ref[T]) valueType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// JSONSchema returns a JSON Schema (draft 2020-12, as used by OpenAPI 3.1)
+// describing how encoding/json encodes values of v's type. Optional,
+// TriState and Ref fields are documented as their value type plus null
+// and are never listed as required; other fields are required unless
+// tagged omitempty or omitzero.
+//
+// Recursive types are cut off with an empty schema at the point where they
+// refer back to themselves.
+func JSONSchema(v any) map[string]any {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return map[string]any{}
+	}
+	return jsonSchema(t, map[reflect.Type]bool{})
+}
+
+func jsonSchema(t reflect.Type, seen map[reflect.Type]bool) map[string]any {
+	switch {
+	case t.Kind() == reflect.Pointer:
+		// Pointers come first: they have the value methods of their
+		// element type, so the checks below would match them too.
+		return nullableSchema(jsonSchema(t.Elem(), seen))
+	case isSchemaValuer(t):
+		return nullableSchema(jsonSchema(reflect.Zero(t).Interface().(schemaValuer).valueType(), seen))
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		return map[string]any{}
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return map[string]any{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "contentEncoding": "base64"}
+		}
+		return nullableSchema(map[string]any{"type": "array", "items": jsonSchema(t.Elem(), seen)})
+	case reflect.Array:
+		return map[string]any{
+			"type":     "array",
+			"items":    jsonSchema(t.Elem(), seen),
+			"minItems": t.Len(),
+			"maxItems": t.Len(),
+		}
+	case reflect.Map:
+		return nullableSchema(map[string]any{"type": "object", "additionalProperties": jsonSchema(t.Elem(), seen)})
+	case reflect.Struct:
+		if seen[t] {
+			return map[string]any{}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		properties := map[string]any{}
+		var required []string
+		addStructSchema(t, properties, &required, seen)
+		s := map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			s["required"] = required
+		}
+		return s
+	}
+	// Interfaces, and kinds encoding/json rejects, accept anything.
+	return map[string]any{}
+}
+
+// isSchemaValuer reports whether t is one of the wrapper types implementing
+// schemaValuer, not a pointer to one.
+func isSchemaValuer(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.Implements(schemaValuerType)
+}
+
+// addStructSchema adds the fields of the struct t to properties, flattening
+// untagged embedded structs the way encoding/json does.
+func addStructSchema(t reflect.Type, properties map[string]any, required *[]string, seen map[reflect.Type]bool) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		ft := field.Type
+		if field.Anonymous && name == "" {
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && !isSchemaValuer(ft) {
+				addStructSchema(ft, properties, required, seen)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = jsonSchema(field.Type, seen)
+		optional := isSchemaValuer(field.Type) ||
+			strings.Contains(","+opts+",", ",omitempty,") ||
+			strings.Contains(","+opts+",", ",omitzero,")
+		if !optional {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// nullableSchema returns s extended to also accept null.
+func nullableSchema(s map[string]any) map[string]any {
+	switch typ := s["type"].(type) {
+	case nil:
+		if len(s) == 0 {
+			// The empty schema already accepts null.
+			return s
+		}
+	case string:
+		s["type"] = []string{typ, "null"}
+		return s
+	case []string:
+		// Already nullable.
+		return s
+	}
+	return map[string]any{"anyOf": []any{s, map[string]any{"type": "null"}}}
+}
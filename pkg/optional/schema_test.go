@@ -0,0 +1,110 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type schemaAddress struct {
+	City string `json:"city"`
+}
+
+type schemaNode struct {
+	Name string      `json:"name"`
+	Next *schemaNode `json:"next,omitempty"`
+}
+
+type schemaBase struct {
+	ID int64 `json:"id"`
+}
+
+type schemaUser struct {
+	schemaBase
+	Name     string                  `json:"name"`
+	Nick     Optional[string]        `json:"nick"`
+	Age      Optional[int]           `json:"age,omitzero"`
+	Email    TriState[string]        `json:"email"`
+	Address  Optional[schemaAddress] `json:"address"`
+	Tags     []string                `json:"tags,omitempty"`
+	Avatar   []byte                  `json:"avatar,omitempty"`
+	Created  time.Time               `json:"created"`
+	Manager  Ref[schemaUser]         `json:"manager"`
+	Score    *float64                `json:"score"`
+	Skipped  string                  `json:"-"`
+	internal int
+}
+
+func TestJSONSchema(t *testing.T) {
+	cases := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{name: "nil", in: nil, want: `{}`},
+		{name: "int", in: 0, want: `{"type":"integer"}`},
+		{name: "optional string", in: Empty[string](), want: `{"type":["string","null"]}`},
+		{name: "optional any", in: Empty[any](), want: `{}`},
+		{name: "optional slice", in: Empty[[]int](), want: `{"items":{"type":"integer"},"type":["array","null"]}`},
+		{name: "array", in: [2]bool{}, want: `{"items":{"type":"boolean"},"maxItems":2,"minItems":2,"type":"array"}`},
+		{name: "map", in: map[string]float64{}, want: `{"additionalProperties":{"type":"number"},"type":["object","null"]}`},
+		{
+			name: "pointer to optional",
+			in: struct {
+				Name *Optional[string] `json:"name"`
+				Age  *TriState[int]    `json:"age,omitempty"`
+				At   *time.Time        `json:"at,omitempty"`
+			}{},
+			want: `{"properties":{"age":{"type":["integer","null"]},"at":{"format":"date-time","type":["string","null"]},"name":{"type":["string","null"]}},"required":["name"],"type":"object"}`,
+		},
+		{name: "recursive", in: schemaNode{}, want: `{"properties":{"name":{"type":"string"},"next":{}},"required":["name"],"type":"object"}`},
+		{
+			name: "struct",
+			in:   schemaUser{},
+			want: `{"properties":{` +
+				`"address":{"properties":{"city":{"type":"string"}},"required":["city"],"type":["object","null"]},` +
+				`"age":{"type":["integer","null"]},` +
+				`"avatar":{"contentEncoding":"base64","type":"string"},` +
+				`"created":{"format":"date-time","type":"string"},` +
+				`"email":{"type":["string","null"]},` +
+				`"id":{"type":"integer"},` +
+				`"manager":{},` +
+				`"name":{"type":"string"},` +
+				`"nick":{"type":["string","null"]},` +
+				`"score":{"type":["number","null"]},` +
+				`"tags":{"items":{"type":"string"},"type":["array","null"]}` +
+				`},"required":["id","name","created","score"],"type":"object"}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(JSONSchema(tc.in))
+			if err != nil {
+				t.Fatalf("Marshal: unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("JSONSchema:\n got %s\nwant %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaAlias(t *testing.T) {
+	if v, ok := Empty[int]().JSONSchemaAlias().(int); !ok || v != 0 {
+		t.Fatalf("JSONSchemaAlias: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+	if v, ok := Undefined[string]().JSONSchemaAlias().(string); !ok || v != "" {
+		t.Fatalf("TriState JSONSchemaAlias: got (v=%q, ok=%v), want (\"\", true)", v, ok)
+	}
+}
+
+func TestJSONSchemaBytes(t *testing.T) {
+	got, err := New(1.5).JSONSchemaBytes()
+	if err != nil || string(got) != `{"type":["number","null"]}` {
+		t.Fatalf("JSONSchemaBytes: got (%s, %v)", got, err)
+	}
+	got, err = Null[bool]().JSONSchemaBytes()
+	if err != nil || string(got) != `{"type":["boolean","null"]}` {
+		t.Fatalf("TriState JSONSchemaBytes: got (%s, %v)", got, err)
+	}
+}
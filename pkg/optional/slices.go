@@ -0,0 +1,91 @@
+package optional
+
+// Collect returns the values of the present Optionals in os, in order.
+func Collect[T any](os []Optional[T]) []T {
+	values := make([]T, 0, len(os))
+	for _, o := range os {
+		if o.hasValue {
+			values = append(values, o.value)
+		}
+	}
+	return values
+}
+
+// CollectWithIndex is like Collect, but pairs each value with its index in
+// os.
+func CollectWithIndex[T any](os []Optional[T]) []Pair[int, T] {
+	values := make([]Pair[int, T], 0, len(os))
+	for i, o := range os {
+		if o.hasValue {
+			values = append(values, Pair[int, T]{First: i, Second: o.value})
+		}
+	}
+	return values
+}
+
+// Sequence returns all values of os if every Optional is present, and an
+// empty Optional otherwise. An empty os yields an empty, present slice.
+func Sequence[T any](os []Optional[T]) Optional[[]T] {
+	values := make([]T, 0, len(os))
+	for _, o := range os {
+		if !o.hasValue {
+			return Optional[[]T]{}
+		}
+		values = append(values, o.value)
+	}
+	return New(values)
+}
+
+// FindFirst returns the first element of s that satisfies pred, or an
+// empty Optional if none does.
+func FindFirst[T any](s []T, pred func(T) bool) Optional[T] {
+	for _, v := range s {
+		if pred(v) {
+			return New(v)
+		}
+	}
+	return Optional[T]{}
+}
+
+// FindLast returns the last element of s that satisfies pred, or an empty
+// Optional if none does.
+func FindLast[T any](s []T, pred func(T) bool) Optional[T] {
+	for i := len(s) - 1; i >= 0; i-- {
+		if pred(s[i]) {
+			return New(s[i])
+		}
+	}
+	return Optional[T]{}
+}
+
+// SliceIndex returns s[i], or an empty Optional if i is out of range.
+func SliceIndex[T any](s []T, i int) Optional[T] {
+	if i < 0 || i >= len(s) {
+		return Optional[T]{}
+	}
+	return New(s[i])
+}
+
+// SliceFirst returns the first element of s, or an empty Optional if s is
+// empty.
+func SliceFirst[T any](s []T) Optional[T] {
+	return SliceIndex(s, 0)
+}
+
+// SliceLast returns the last element of s, or an empty Optional if s is
+// empty.
+func SliceLast[T any](s []T) Optional[T] {
+	return SliceIndex(s, len(s)-1)
+}
+
+// Fold combines the present values of os into an accumulator, starting from
+// init and calling f for each value in order. Empty Optionals are skipped.
+func Fold[T, A any](os []Optional[T], init A, f func(A, T) A) A {
+	acc := init
+	for _, o := range os {
+		if o.hasValue {
+			acc = f(acc, o.value)
+		}
+	}
+	return acc
+}
@@ -0,0 +1,106 @@
+package optional
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	os := []Optional[int]{New(1), Empty[int](), New(0), Empty[int](), New(3)}
+
+	if got, want := Collect(os), []int{1, 0, 3}; !slices.Equal(got, want) {
+		t.Fatalf("Collect: got %v, want %v", got, want)
+	}
+	if got := Collect[int](nil); got == nil || len(got) != 0 {
+		t.Fatalf("Collect(nil): got %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestCollectWithIndex(t *testing.T) {
+	os := []Optional[string]{Empty[string](), New("b"), Empty[string](), New("d")}
+
+	got := CollectWithIndex(os)
+	want := []Pair[int, string]{{First: 1, Second: "b"}, {First: 3, Second: "d"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("CollectWithIndex: got %v, want %v", got, want)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	got, ok := Sequence([]Optional[int]{New(1), New(2)}).Get()
+	if !ok || !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Sequence: got (v=%v, ok=%v), want ([1 2], true)", got, ok)
+	}
+
+	if !Sequence([]Optional[int]{New(1), Empty[int]()}).IsEmpty() {
+		t.Fatalf("Sequence: expected empty when any element is empty")
+	}
+
+	if got, ok := Sequence[int](nil).Get(); !ok || len(got) != 0 {
+		t.Fatalf("Sequence(nil): got (v=%v, ok=%v), want ([], true)", got, ok)
+	}
+}
+
+func TestFindFirstLast(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	even := func(v int) bool { return v%2 == 0 }
+
+	if v, ok := FindFirst(s, even).Get(); !ok || v != 2 {
+		t.Fatalf("FindFirst: got (v=%v, ok=%v), want (2, true)", v, ok)
+	}
+	if v, ok := FindLast(s, even).Get(); !ok || v != 4 {
+		t.Fatalf("FindLast: got (v=%v, ok=%v), want (4, true)", v, ok)
+	}
+
+	never := func(int) bool { return false }
+	if !FindFirst(s, never).IsEmpty() || !FindLast(s, never).IsEmpty() {
+		t.Fatalf("FindFirst/FindLast: expected empty when nothing matches")
+	}
+	if !FindFirst(nil, even).IsEmpty() || !FindLast(nil, even).IsEmpty() {
+		t.Fatalf("FindFirst/FindLast: expected empty for a nil slice")
+	}
+}
+
+func TestSliceAccessors(t *testing.T) {
+	s := []string{"a", "b", "c"}
+
+	cases := []struct {
+		name string
+		got  Optional[string]
+		want Optional[string]
+	}{
+		{name: "index", got: SliceIndex(s, 1), want: New("b")},
+		{name: "negative index", got: SliceIndex(s, -1), want: Empty[string]()},
+		{name: "index past end", got: SliceIndex(s, 3), want: Empty[string]()},
+		{name: "first", got: SliceFirst(s), want: New("a")},
+		{name: "last", got: SliceLast(s), want: New("c")},
+		{name: "first of nil", got: SliceFirst[string](nil), want: Empty[string]()},
+		{name: "last of nil", got: SliceLast[string](nil), want: Empty[string]()},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !Equal(tc.got, tc.want) {
+				t.Fatalf("got %v, want %v", tc.got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	os := []Optional[int]{New(1), Empty[int](), New(2), New(3)}
+
+	sum := Fold(os, 0, func(acc, v int) int { return acc + v })
+	if sum != 6 {
+		t.Fatalf("Fold sum: got %d, want 6", sum)
+	}
+
+	joined := Fold(os, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if joined != "123" {
+		t.Fatalf("Fold join: got %q, want \"123\"", joined)
+	}
+
+	if got := Fold(nil, 42, func(acc, v int) int { return acc + v }); got != 42 {
+		t.Fatalf("Fold(nil): got %d, want 42", got)
+	}
+}
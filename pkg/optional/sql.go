@@ -0,0 +1,63 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner.
+// SQL NULL unsets the optional; any other value is converted into T
+// the same way database/sql converts column values for Rows.Scan.
+func (o *Optional[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	if !n.Valid {
+		o.Unset()
+		return nil
+	}
+	o.Set(n.V)
+	return nil
+}
+
+// Value implements driver.Valuer.
+// Empty optionals produce SQL NULL; present ones the driver value of T.
+func (o Optional[T]) Value() (driver.Value, error) {
+	return o.ToSQLNull().Value()
+}
+
+// FromSQLNull converts a sql.Null into an Optional.
+func FromSQLNull[T any](n sql.Null[T]) Optional[T] {
+	return FromOk(n.V, n.Valid)
+}
+
+// ToSQLNull converts the Optional into a sql.Null.
+func (o Optional[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{V: o.value, Valid: o.hasValue}
+}
+
+// Scan implements sql.Scanner.
+// SQL NULL becomes Null; any other value is converted into T and becomes
+// a Value.
+func (s *TriState[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	if !n.Valid {
+		*s = Null[T]()
+		return nil
+	}
+	*s = Value(n.V)
+	return nil
+}
+
+// Value implements driver.Valuer.
+// Null and Undefined produce SQL NULL. ORMs that skip zero-valued fields,
+// such as GORM's Updates, leave Undefined columns untouched, so a Null
+// TriState clears a column while an Undefined one keeps it.
+func (s TriState[T]) Value() (driver.Value, error) {
+	v, ok := s.Get()
+	return sql.Null[T]{V: v, Valid: ok}.Value()
+}
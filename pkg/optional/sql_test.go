@@ -0,0 +1,189 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+var (
+	_ sql.Scanner   = (*Optional[int])(nil)
+	_ driver.Valuer = Optional[int]{}
+	_ sql.Scanner   = (*TriState[int])(nil)
+	_ driver.Valuer = TriState[int]{}
+)
+
+func TestScanNull(t *testing.T) {
+	o := New(5)
+	if err := o.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil): unexpected error: %v", err)
+	}
+	if !o.IsEmpty() {
+		t.Fatalf("Scan(nil) should unset the Optional")
+	}
+}
+
+func TestScanConvertsValues(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("int64", func(t *testing.T) {
+		var o Optional[int64]
+		if err := o.Scan(int64(7)); err != nil {
+			t.Fatalf("Scan: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || v != 7 {
+			t.Fatalf("Get: got (v=%v, ok=%v), want (7, true)", v, ok)
+		}
+	})
+
+	t.Run("int64 into int", func(t *testing.T) {
+		var o Optional[int]
+		if err := o.Scan(int64(8)); err != nil {
+			t.Fatalf("Scan: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || v != 8 {
+			t.Fatalf("Get: got (v=%v, ok=%v), want (8, true)", v, ok)
+		}
+	})
+
+	t.Run("bytes into string", func(t *testing.T) {
+		var o Optional[string]
+		if err := o.Scan([]byte("abc")); err != nil {
+			t.Fatalf("Scan: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || v != "abc" {
+			t.Fatalf("Get: got (v=%q, ok=%v), want (\"abc\", true)", v, ok)
+		}
+	})
+
+	t.Run("bytes are copied", func(t *testing.T) {
+		src := []byte("abc")
+		var o Optional[[]byte]
+		if err := o.Scan(src); err != nil {
+			t.Fatalf("Scan: unexpected error: %v", err)
+		}
+		src[0] = 'x'
+		if v, ok := o.Get(); !ok || string(v) != "abc" {
+			t.Fatalf("Get: got (v=%q, ok=%v), want (\"abc\", true)", v, ok)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		var o Optional[time.Time]
+		if err := o.Scan(ts); err != nil {
+			t.Fatalf("Scan: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || !v.Equal(ts) {
+			t.Fatalf("Get: got (v=%v, ok=%v), want (%v, true)", v, ok, ts)
+		}
+	})
+
+	t.Run("delegates to scanner", func(t *testing.T) {
+		var o Optional[sql.NullInt32]
+		if err := o.Scan(int64(3)); err != nil {
+			t.Fatalf("Scan: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || !v.Valid || v.Int32 != 3 {
+			t.Fatalf("Get: got (v=%+v, ok=%v), want ({Int32:3 Valid:true}, true)", v, ok)
+		}
+	})
+}
+
+func TestScanInvalidValue(t *testing.T) {
+	o := New(1)
+	if err := o.Scan("not a number"); err == nil {
+		t.Fatalf("Scan: expected error for invalid value")
+	}
+	if v, ok := o.Get(); !ok || v != 1 {
+		t.Fatalf("failed Scan must not modify the Optional: got (v=%v, ok=%v)", v, ok)
+	}
+}
+
+func TestValue(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		in   driver.Valuer
+		want driver.Value
+	}{
+		{name: "empty", in: Empty[string](), want: nil},
+		{name: "string", in: New("a"), want: "a"},
+		{name: "int converts to int64", in: New(3), want: int64(3)},
+		{name: "bool", in: New(true), want: true},
+		{name: "time", in: New(ts), want: ts},
+		{name: "delegates to valuer", in: New(sql.NullString{String: "x", Valid: true}), want: "x"},
+		{name: "present valuer returning null", in: New(sql.NullString{}), want: nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.in.Value()
+			if err != nil {
+				t.Fatalf("Value: unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("Value: got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValueUnsupportedType(t *testing.T) {
+	if _, err := New(struct{ A int }{A: 1}).Value(); err == nil {
+		t.Fatalf("Value: expected error for unsupported type")
+	}
+}
+
+func TestSQLNullConversions(t *testing.T) {
+	if v, ok := FromSQLNull(sql.Null[int]{V: 3, Valid: true}).Get(); !ok || v != 3 {
+		t.Fatalf("FromSQLNull(valid): got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+	if o := FromSQLNull(sql.Null[int]{V: 3}); !o.IsEmpty() {
+		t.Fatalf("FromSQLNull(invalid) should be empty")
+	}
+
+	if got := New("a").ToSQLNull(); got != (sql.Null[string]{V: "a", Valid: true}) {
+		t.Fatalf("ToSQLNull(present): got %+v, want {V:a Valid:true}", got)
+	}
+	if got := Empty[string]().ToSQLNull(); got != (sql.Null[string]{}) {
+		t.Fatalf("ToSQLNull(empty): got %+v, want {}", got)
+	}
+}
+
+func TestTriStateScan(t *testing.T) {
+	s := Value(5)
+	if err := s.Scan(nil); err != nil || !s.IsNull() {
+		t.Fatalf("Scan(nil): got (%v, %v), want Null", s, err)
+	}
+	if err := s.Scan(int64(7)); err != nil {
+		t.Fatalf("Scan: unexpected error: %v", err)
+	}
+	if v, ok := s.Get(); !ok || v != 7 {
+		t.Fatalf("Scan: got (v=%v, ok=%v), want (7, true)", v, ok)
+	}
+	if err := s.Scan("x"); err == nil {
+		t.Fatalf("Scan: expected error for a string into int")
+	}
+}
+
+func TestTriStateValue(t *testing.T) {
+	cases := []struct {
+		name string
+		in   TriState[string]
+		want driver.Value
+	}{
+		{name: "undefined", in: Undefined[string](), want: nil},
+		{name: "null", in: Null[string](), want: nil},
+		{name: "value", in: Value("a"), want: "a"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.in.Value()
+			if err != nil || got != tc.want {
+				t.Fatalf("Value: got (%v, %v), want %v", got, err, tc.want)
+			}
+		})
+	}
+}
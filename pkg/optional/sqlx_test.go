@@ -0,0 +1,123 @@
+package optional
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "modernc.org/sqlite"
+)
+
+type sqlxUser struct {
+	ID      int64               `db:"id"`
+	Name    string              `db:"name"`
+	Email   Optional[string]    `db:"email"`
+	Age     Optional[int64]     `db:"age"`
+	Score   Optional[float64]   `db:"score"`
+	Active  Optional[bool]      `db:"active"`
+	Created Optional[time.Time] `db:"created"`
+}
+
+func openSQLX(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Open("sqlite", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("sqlx.Open: unexpected error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`CREATE TABLE users (
+		id      INTEGER PRIMARY KEY,
+		name    TEXT NOT NULL,
+		email   TEXT,
+		age     INTEGER,
+		score   REAL,
+		active  BOOLEAN,
+		created DATETIME
+	)`); err != nil {
+		t.Fatalf("CREATE TABLE: unexpected error: %v", err)
+	}
+	return db
+}
+
+func TestSQLXNamedExecAndGet(t *testing.T) {
+	db := openSQLX(t)
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	users := []sqlxUser{
+		{ID: 1, Name: "empty"},
+		{ID: 2, Name: "zero", Email: New(""), Age: New[int64](0), Score: New(0.0), Active: New(false), Created: New(time.Time{})},
+		{ID: 3, Name: "full", Email: New("a@b.c"), Age: New[int64](42), Score: New(1.5), Active: New(true), Created: New(created)},
+	}
+	const insert = `INSERT INTO users (id, name, email, age, score, active, created)
+		VALUES (:id, :name, :email, :age, :score, :active, :created)`
+	for _, u := range users {
+		if _, err := db.NamedExec(insert, u); err != nil {
+			t.Fatalf("NamedExec(%s): unexpected error: %v", u.Name, err)
+		}
+	}
+
+	var nulls int
+	if err := db.Get(&nulls, `SELECT COUNT(*) FROM users
+		WHERE email IS NULL AND age IS NULL AND score IS NULL AND active IS NULL AND created IS NULL`); err != nil {
+		t.Fatalf("Get: unexpected error: %v", err)
+	}
+	if nulls != 1 {
+		t.Fatalf("empty Optionals: got %d all-NULL rows, want 1", nulls)
+	}
+
+	for _, want := range users {
+		var got sqlxUser
+		if err := db.Get(&got, `SELECT * FROM users WHERE id = ?`, want.ID); err != nil {
+			t.Fatalf("Get(%s): unexpected error: %v", want.Name, err)
+		}
+		if got.Email != want.Email || got.Age != want.Age || got.Score != want.Score || got.Active != want.Active {
+			t.Fatalf("Get(%s): got %+v, want %+v", want.Name, got, want)
+		}
+		gc, gok := got.Created.Get()
+		wc, wok := want.Created.Get()
+		if gok != wok || !gc.Equal(wc) {
+			t.Fatalf("Get(%s): Created: got (v=%v, ok=%v), want (%v, %v)", want.Name, gc, gok, wc, wok)
+		}
+	}
+}
+
+func TestSQLXSelectAndNamedQuery(t *testing.T) {
+	db := openSQLX(t)
+	if _, err := db.Exec(`INSERT INTO users (id, name, age) VALUES (1, 'a', NULL), (2, 'b', 7)`); err != nil {
+		t.Fatalf("INSERT: unexpected error: %v", err)
+	}
+
+	var users []sqlxUser
+	if err := db.Select(&users, `SELECT * FROM users ORDER BY id`); err != nil {
+		t.Fatalf("Select: unexpected error: %v", err)
+	}
+	if len(users) != 2 || !users[0].Age.IsEmpty() || users[1].Age != New[int64](7) {
+		t.Fatalf("Select: got %+v", users)
+	}
+
+	// An empty Optional binds as NULL, which matches nothing with =.
+	rows, err := db.NamedQuery(`SELECT * FROM users WHERE age = :age`, map[string]any{"age": Empty[int64]()})
+	if err != nil {
+		t.Fatalf("NamedQuery: unexpected error: %v", err)
+	}
+	defer rows.Close()
+	if rows.Next() {
+		t.Fatalf("NamedQuery: an empty Optional should bind as NULL")
+	}
+
+	rows, err = db.NamedQuery(`SELECT * FROM users WHERE age = :age`, sqlxUser{Age: New[int64](7)})
+	if err != nil {
+		t.Fatalf("NamedQuery: unexpected error: %v", err)
+	}
+	defer rows.Close()
+	var got sqlxUser
+	if !rows.Next() {
+		t.Fatalf("NamedQuery: expected a row")
+	}
+	if err := rows.StructScan(&got); err != nil {
+		t.Fatalf("StructScan: unexpected error: %v", err)
+	}
+	if got.Name != "b" {
+		t.Fatalf("StructScan: got %+v, want the row named b", got)
+	}
+}
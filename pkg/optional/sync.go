@@ -0,0 +1,42 @@
+package optional
+
+import "sync"
+
+// Sync is an Optional guarded by a mutex, for state shared between
+// goroutines. The zero value is empty and ready to use.
+//
+// Sync must not be copied after first use.
+type Sync[T any] struct {
+	mu sync.RWMutex
+	o  Optional[T]
+}
+
+// Get returns the current value.
+func (s *Sync[T]) Get() Optional[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.o
+}
+
+// Set stores value.
+func (s *Sync[T]) Set(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.o.Set(value)
+}
+
+// Unset empties the optional.
+func (s *Sync[T]) Unset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.o.Unset()
+}
+
+// Update replaces the current value with f(current) and returns the result.
+// The lock is held while f runs, so f must not call back into s.
+func (s *Sync[T]) Update(f func(Optional[T]) Optional[T]) Optional[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.o = f(s.o)
+	return s.o
+}
@@ -0,0 +1,49 @@
+package optional
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncGetSetUnset(t *testing.T) {
+	var s Sync[string]
+	if !s.Get().IsEmpty() {
+		t.Fatalf("Get: zero Sync should be empty")
+	}
+
+	s.Set("a")
+	if v, ok := s.Get().Get(); !ok || v != "a" {
+		t.Fatalf("Get: got (v=%q, ok=%v), want (\"a\", true)", v, ok)
+	}
+
+	s.Unset()
+	if !s.Get().IsEmpty() {
+		t.Fatalf("Get: expected empty after Unset")
+	}
+}
+
+func TestSyncUpdate(t *testing.T) {
+	var s Sync[int]
+	incr := func(o Optional[int]) Optional[int] {
+		return New(o.Or(0) + 1)
+	}
+
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Update(incr)
+		}()
+	}
+	wg.Wait()
+
+	if v, ok := s.Get().Get(); !ok || v != 100 {
+		t.Fatalf("Get: got (v=%v, ok=%v), want (100, true)", v, ok)
+	}
+
+	got := s.Update(func(Optional[int]) Optional[int] { return Empty[int]() })
+	if !got.IsEmpty() || !s.Get().IsEmpty() {
+		t.Fatalf("Update: returning an empty Optional should unset the value")
+	}
+}
@@ -0,0 +1,132 @@
+package optional
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncMap is a map guarded by a mutex, like a typed sync.Map whose lookups
+// return an Optional instead of (any, bool). The zero value is empty and
+// ready to use.
+//
+// SyncMap must not be copied after first use.
+type SyncMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// Load returns the value stored for key, or an empty Optional if there is
+// none.
+func (m *SyncMap[K, V]) Load(key K) Optional[V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return MapLookup(m.m, key)
+}
+
+// Store sets the value for key.
+func (m *SyncMap[K, V]) Store(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.store(key, value)
+}
+
+// LoadOrStore returns the existing value for key if there is one and true.
+// Otherwise it stores value and returns it and false.
+func (m *SyncMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.m[key]; ok {
+		return v, true
+	}
+	m.store(key, value)
+	return value, false
+}
+
+// Swap stores value for key and returns the previous value, if any.
+func (m *SyncMap[K, V]) Swap(key K, value V) Optional[V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	prev := MapLookup(m.m, key)
+	m.store(key, value)
+	return prev
+}
+
+// LoadAndDelete deletes the value for key and returns it, if any.
+func (m *SyncMap[K, V]) LoadAndDelete(key K) Optional[V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	prev := MapLookup(m.m, key)
+	delete(m.m, key)
+	return prev
+}
+
+// Delete deletes the value for key.
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.m, key)
+}
+
+// CompareAndSwap stores new for key if the value stored for key equals old,
+// and reports whether it did. Like sync.Map.CompareAndSwap, it panics if V
+// is not comparable.
+func (m *SyncMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !equalAny(MapLookup(m.m, key), New(old)) {
+		return false
+	}
+	m.m[key] = new
+	return true
+}
+
+// CompareAndDelete deletes the value for key if it equals old, and reports
+// whether it did. Like sync.Map.CompareAndDelete, it panics if V is not
+// comparable.
+func (m *SyncMap[K, V]) CompareAndDelete(key K, old V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !equalAny(MapLookup(m.m, key), New(old)) {
+		return false
+	}
+	delete(m.m, key)
+	return true
+}
+
+// Len returns the number of stored values.
+func (m *SyncMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.m)
+}
+
+// All returns an iterator over the keys and values in m, in no particular
+// order. It iterates over a snapshot taken when iteration starts, so the
+// loop body may call any method of m.
+func (m *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		type entry struct {
+			k K
+			v V
+		}
+		m.mu.RLock()
+		entries := make([]entry, 0, len(m.m))
+		for k, v := range m.m {
+			entries = append(entries, entry{k, v})
+		}
+		m.mu.RUnlock()
+
+		for _, e := range entries {
+			if !yield(e.k, e.v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *SyncMap[K, V]) store(key K, value V) {
+	if m.m == nil {
+		m.m = make(map[K]V)
+	}
+	m.m[key] = value
+}
@@ -0,0 +1,117 @@
+package optional
+
+import (
+	"maps"
+	"sync"
+	"testing"
+)
+
+func TestSyncMapLoadStoreDelete(t *testing.T) {
+	var m SyncMap[string, int]
+	if o := m.Load("a"); !o.IsEmpty() {
+		t.Fatalf("Load on zero SyncMap: got %v, want empty", o)
+	}
+
+	m.Store("a", 1)
+	if v, ok := m.Load("a").Get(); !ok || v != 1 {
+		t.Fatalf("Load: got (v=%v, ok=%v), want (1, true)", v, ok)
+	}
+	if v, ok := m.Swap("a", 2).Get(); !ok || v != 1 {
+		t.Fatalf("Swap: got (v=%v, ok=%v), want (1, true)", v, ok)
+	}
+	if o := m.Swap("b", 3); !o.IsEmpty() {
+		t.Fatalf("Swap of new key: got %v, want empty", o)
+	}
+	if n := m.Len(); n != 2 {
+		t.Fatalf("Len: got %d, want 2", n)
+	}
+
+	if v, ok := m.LoadAndDelete("a").Get(); !ok || v != 2 {
+		t.Fatalf("LoadAndDelete: got (v=%v, ok=%v), want (2, true)", v, ok)
+	}
+	if o := m.LoadAndDelete("a"); !o.IsEmpty() {
+		t.Fatalf("LoadAndDelete of deleted key: got %v, want empty", o)
+	}
+	m.Delete("b")
+	if n := m.Len(); n != 0 {
+		t.Fatalf("Len after Delete: got %d, want 0", n)
+	}
+}
+
+func TestSyncMapLoadOrStore(t *testing.T) {
+	var m SyncMap[string, int]
+	if v, loaded := m.LoadOrStore("a", 1); loaded || v != 1 {
+		t.Fatalf("LoadOrStore: got (v=%v, loaded=%v), want (1, false)", v, loaded)
+	}
+	if v, loaded := m.LoadOrStore("a", 2); !loaded || v != 1 {
+		t.Fatalf("LoadOrStore: got (v=%v, loaded=%v), want (1, true)", v, loaded)
+	}
+}
+
+func TestSyncMapCompare(t *testing.T) {
+	var m SyncMap[string, int]
+	if m.CompareAndSwap("a", 0, 1) {
+		t.Fatalf("CompareAndSwap of missing key: got true, want false")
+	}
+	m.Store("a", 1)
+	if m.CompareAndSwap("a", 2, 3) {
+		t.Fatalf("CompareAndSwap with wrong old value: got true, want false")
+	}
+	if !m.CompareAndSwap("a", 1, 3) {
+		t.Fatalf("CompareAndSwap: got false, want true")
+	}
+	if m.CompareAndDelete("a", 1) {
+		t.Fatalf("CompareAndDelete with wrong old value: got true, want false")
+	}
+	if !m.CompareAndDelete("a", 3) {
+		t.Fatalf("CompareAndDelete: got false, want true")
+	}
+	if o := m.Load("a"); !o.IsEmpty() {
+		t.Fatalf("Load after CompareAndDelete: got %v, want empty", o)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("CompareAndSwap with non-comparable values: expected panic")
+		}
+	}()
+	var s SyncMap[string, []int]
+	s.Store("a", nil)
+	s.CompareAndSwap("a", nil, []int{1})
+}
+
+func TestSyncMapAll(t *testing.T) {
+	var m SyncMap[string, int]
+	m.Store("a", 1)
+	m.Store("b", 2)
+
+	got := map[string]int{}
+	for k, v := range m.All() {
+		got[k] = v
+		m.Delete(k) // the loop body may modify m
+	}
+	if want := map[string]int{"a": 1, "b": 2}; !maps.Equal(got, want) {
+		t.Fatalf("All: got %v, want %v", got, want)
+	}
+	if n := m.Len(); n != 0 {
+		t.Fatalf("Len: got %d, want 0", n)
+	}
+}
+
+func TestSyncMapConcurrent(t *testing.T) {
+	var m SyncMap[int, int]
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.LoadOrStore(i%10, i)
+			m.Load(i % 10)
+		}()
+	}
+	wg.Wait()
+
+	if n := m.Len(); n != 10 {
+		t.Fatalf("Len: got %d, want 10", n)
+	}
+}
@@ -0,0 +1,48 @@
+package optional
+
+import "github.com/Palladium-blockchain/go-optional/internal/textconv"
+
+// EmptyText is the text form of an empty Optional used by MarshalText and
+// UnmarshalText. A present empty string cannot be told apart from an empty
+// Optional after a round trip; use WithPolicy with a policy implementing
+// EmptyTextPolicy to choose a different token for a field.
+const EmptyText = ""
+
+// MarshalText implements encoding.TextMarshaler.
+// Empty optionals are encoded as EmptyText. Present values use T's own
+// MarshalText if it has one; strings, booleans, numbers and durations are
+// formatted with strconv, and URLs with url.URL.String.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	if !o.hasValue {
+		return []byte(EmptyText), nil
+	}
+	return textconv.Format(o.value)
+}
+
+// AppendText implements encoding.TextAppender. It appends the same bytes
+// MarshalText returns, without allocating for strings, booleans and numbers.
+func (o Optional[T]) AppendText(dst []byte) ([]byte, error) {
+	if !o.hasValue {
+		return append(dst, EmptyText...), nil
+	}
+	if out, ok := textconv.AppendScalar(dst, o.value); ok {
+		return out, nil
+	}
+	return textconv.Append(dst, o.value)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// EmptyText unsets the optional; otherwise the text is parsed into T the
+// same way MarshalText formats it.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	if string(text) == EmptyText {
+		o.Unset()
+		return nil
+	}
+	var v T
+	if err := textconv.Parse(&v, string(text)); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
@@ -0,0 +1,187 @@
+package optional
+
+import (
+	"encoding"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+var (
+	_ encoding.TextMarshaler   = Optional[int]{}
+	_ encoding.TextUnmarshaler = (*Optional[int])(nil)
+	_ encoding.TextAppender    = Optional[int]{}
+)
+
+type textLevel int
+
+func TestMarshalText(t *testing.T) {
+	cases := []struct {
+		name string
+		in   encoding.TextMarshaler
+		want string
+	}{
+		{name: "empty", in: Empty[int](), want: ""},
+		{name: "string", in: New("a b"), want: "a b"},
+		{name: "int", in: New(-12), want: "-12"},
+		{name: "named int", in: New(textLevel(3)), want: "3"},
+		{name: "uint8", in: New[uint8](255), want: "255"},
+		{name: "float", in: New(1.5), want: "1.5"},
+		{name: "bool", in: New(true), want: "true"},
+		{name: "duration", in: New(90 * time.Second), want: "1m30s"},
+		{name: "bytes", in: New([]byte("raw")), want: "raw"},
+		{name: "text marshaler", in: New(netip.MustParseAddr("10.0.0.1")), want: "10.0.0.1"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.in.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText: unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("MarshalText: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMarshalTextUnsupportedType(t *testing.T) {
+	if _, err := New(struct{}{}).MarshalText(); err == nil {
+		t.Fatalf("MarshalText: expected error for unsupported type")
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Run("empty text unsets", func(t *testing.T) {
+		o := New(1)
+		if err := o.UnmarshalText(nil); err != nil {
+			t.Fatalf("UnmarshalText: unexpected error: %v", err)
+		}
+		if !o.IsEmpty() {
+			t.Fatalf("UnmarshalText(\"\") should unset the Optional")
+		}
+	})
+
+	t.Run("int", func(t *testing.T) {
+		var o Optional[textLevel]
+		if err := o.UnmarshalText([]byte("7")); err != nil {
+			t.Fatalf("UnmarshalText: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || v != 7 {
+			t.Fatalf("Get: got (v=%v, ok=%v), want (7, true)", v, ok)
+		}
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		var o Optional[time.Duration]
+		if err := o.UnmarshalText([]byte("1m30s")); err != nil {
+			t.Fatalf("UnmarshalText: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || v != 90*time.Second {
+			t.Fatalf("Get: got (v=%v, ok=%v), want (1m30s, true)", v, ok)
+		}
+	})
+
+	t.Run("text unmarshaler", func(t *testing.T) {
+		var o Optional[netip.Addr]
+		if err := o.UnmarshalText([]byte("10.0.0.1")); err != nil {
+			t.Fatalf("UnmarshalText: unexpected error: %v", err)
+		}
+		if v, ok := o.Get(); !ok || v != netip.MustParseAddr("10.0.0.1") {
+			t.Fatalf("Get: got (v=%v, ok=%v), want (10.0.0.1, true)", v, ok)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		o := New(true)
+		if err := o.UnmarshalText([]byte("maybe")); err == nil {
+			t.Fatalf("UnmarshalText: expected error")
+		}
+		if v, ok := o.Get(); !ok || !v {
+			t.Fatalf("failed UnmarshalText must not modify the Optional: got (v=%v, ok=%v)", v, ok)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		var o Optional[int8]
+		if err := o.UnmarshalText([]byte("300")); err == nil {
+			t.Fatalf("UnmarshalText: expected overflow error")
+		}
+	})
+}
+
+type dashPolicy struct{}
+
+func (dashPolicy) EmptyJSON() []byte { return []byte("null") }
+func (dashPolicy) EmptyText() string { return "-" }
+
+func TestEmptyTextToken(t *testing.T) {
+	got, err := WithPolicy[string, dashPolicy]{}.MarshalText()
+	if err != nil || string(got) != "-" {
+		t.Fatalf("MarshalText(empty): got (%q, %v), want (\"-\", nil)", got, err)
+	}
+	if got, _ := Empty[string]().MarshalText(); string(got) != EmptyText {
+		t.Fatalf("Optional.MarshalText(empty): got %q, want %q", got, EmptyText)
+	}
+
+	var o WithPolicy[string, dashPolicy]
+	if err := o.UnmarshalText([]byte("")); err != nil {
+		t.Fatalf("UnmarshalText: unexpected error: %v", err)
+	}
+	if v, ok := o.Get(); !ok || v != "" {
+		t.Fatalf("with a custom token \"\" is a present value: got (v=%q, ok=%v)", v, ok)
+	}
+
+	if err := o.UnmarshalText([]byte("-")); err != nil {
+		t.Fatalf("UnmarshalText: unexpected error: %v", err)
+	}
+	if !o.IsEmpty() {
+		t.Fatalf("UnmarshalText(token) should unset the Optional")
+	}
+
+	var n WithPolicy[int, EmptyAsNull]
+	if err := n.UnmarshalText([]byte("7")); err != nil || n.Optional != New(7) {
+		t.Fatalf("UnmarshalText without EmptyTextPolicy: got (%v, %v), want (Some(7), nil)", n.Optional, err)
+	}
+	if err := n.UnmarshalText([]byte(EmptyText)); err != nil || !n.IsEmpty() {
+		t.Fatalf("UnmarshalText(EmptyText): got (%v, %v), want (None, nil)", n.Optional, err)
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	cases := []struct {
+		name string
+		in   encoding.TextAppender
+		want string
+	}{
+		{name: "empty", in: Empty[int](), want: "x="},
+		{name: "string", in: New("a b"), want: "x=a b"},
+		{name: "int", in: New(-12), want: "x=-12"},
+		{name: "named int", in: New(textLevel(3)), want: "x=3"},
+		{name: "duration", in: New(90 * time.Second), want: "x=1m30s"},
+		{name: "addr", in: New(netip.MustParseAddr("::1")), want: "x=::1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.in.AppendText([]byte("x="))
+			if err != nil {
+				t.Fatalf("AppendText: unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("AppendText: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAppendTextAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	o := New(int64(123456))
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = o.AppendText(buf[:0])
+	})
+	if allocs != 0 {
+		t.Fatalf("AppendText: got %v allocations, want 0", allocs)
+	}
+}
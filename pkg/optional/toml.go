@@ -0,0 +1,180 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MarshalTOML implements the Marshaler interface of github.com/BurntSushi/toml
+// (and the opt-in unstable.Marshaler of github.com/pelletier/go-toml/v2).
+// TOML has no null, so empty optionals cannot be encoded; tag such fields
+// with omitempty to leave them out of the document. Floats are always
+// written with a fraction or exponent so they decode as TOML floats.
+//
+// github.com/pelletier/go-toml/v2 only calls MarshalTOML on an Encoder with
+// EnableMarshalerInterface. Its plain Marshal uses MarshalText instead, so
+// values are written as TOML strings (port = '8080'): they decode back into
+// an Optional, but not into a plain int field, and slices, maps and structs
+// fail to encode.
+func (o Optional[T]) MarshalTOML() ([]byte, error) {
+	if !o.hasValue {
+		return nil, errors.New("optional: cannot encode empty Optional as TOML, tag the field with omitempty")
+	}
+	if t, ok := any(o.value).(time.Time); ok {
+		return t.AppendFormat(nil, time.RFC3339Nano), nil
+	}
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, err
+	}
+	return jsonToTOML(data, reflect.TypeFor[T]())
+}
+
+// UnmarshalTOML implements the Unmarshaler interface of github.com/BurntSushi/toml.
+// The decoded TOML value is converted into T through its JSON form. Missing
+// keys are never passed to it, so they leave the optional untouched.
+// github.com/pelletier/go-toml/v2 decodes through UnmarshalText instead.
+func (o *Optional[T]) UnmarshalTOML(value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+var bareTOMLKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// jsonToTOML rewrites a JSON value as the equivalent TOML value. JSON
+// strings, numbers, booleans and arrays are already valid TOML; objects
+// become inline tables and null is rejected. t is the Go type data was
+// encoded from, used to keep floats that JSON writes as integers floats.
+func jsonToTOML(data []byte, t reflect.Type) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return appendTOMLValue(nil, dec, t)
+}
+
+func appendTOMLValue(dst []byte, dec *json.Decoder, t reflect.Type) ([]byte, error) {
+	t = tomlValueType(t)
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch v := tok.(type) {
+	case json.Delim:
+		closing := byte(']')
+		if v == '{' {
+			closing = '}'
+		}
+		dst = append(dst, byte(v))
+		for n := 0; dec.More(); n++ {
+			if n > 0 {
+				dst = append(dst, ',')
+			}
+			elem := tomlElemType(t, "")
+			if v == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				dst = append(dst, ' ')
+				dst = appendTOMLKey(dst, key.(string))
+				dst = append(dst, " = "...)
+				elem = tomlElemType(t, key.(string))
+			} else if n > 0 {
+				dst = append(dst, ' ')
+			}
+			if dst, err = appendTOMLValue(dst, dec, elem); err != nil {
+				return nil, err
+			}
+			if v == '{' && !dec.More() {
+				dst = append(dst, ' ')
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return append(dst, closing), nil
+	case nil:
+		return nil, errors.New("optional: cannot encode null as TOML")
+	case string:
+		quoted, err := json.Marshal(v)
+		return append(dst, quoted...), err
+	case json.Number:
+		dst = append(dst, v...)
+		if t != nil && (t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64) &&
+			!strings.ContainsAny(string(v), ".eE") {
+			dst = append(dst, ".0"...)
+		}
+		return dst, nil
+	case bool:
+		return strconv.AppendBool(dst, v), nil
+	}
+	return nil, fmt.Errorf("optional: unexpected JSON token %v", tok)
+}
+
+// tomlValueType dereferences t and unwraps Optional types, returning nil
+// when t is unknown or encodes itself as JSON, in which case its layout
+// cannot be inferred.
+func tomlValueType(t reflect.Type) reflect.Type {
+	for t != nil {
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		} else if isOptionalType(t) {
+			t = reflect.Zero(t).Interface().(anyOptional).valueType()
+		} else {
+			break
+		}
+	}
+	if t == nil || t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return nil
+	}
+	return t
+}
+
+// tomlElemType returns the type of the element of t found under key, or
+// nil if it is not known.
+func tomlElemType(t reflect.Type, key string) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return t.Elem()
+	case reflect.Struct:
+		for i := range t.NumField() {
+			field := t.Field(i)
+			if !field.IsExported() || field.Anonymous {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" {
+				name = field.Name
+			}
+			if name == key {
+				return field.Type
+			}
+		}
+	}
+	return nil
+}
+
+func appendTOMLKey(dst []byte, key string) []byte {
+	if bareTOMLKey.MatchString(key) {
+		return append(dst, key...)
+	}
+	quoted, _ := json.Marshal(key)
+	return append(dst, quoted...)
+}
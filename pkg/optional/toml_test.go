@@ -0,0 +1,206 @@
+package optional
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	pelletier "github.com/pelletier/go-toml/v2"
+)
+
+var (
+	_ toml.Marshaler   = Optional[int]{}
+	_ toml.Unmarshaler = (*Optional[int])(nil)
+)
+
+type tomlConfig struct {
+	Host    Optional[string]           `toml:"host,omitempty"`
+	Port    Optional[int]              `toml:"port,omitempty"`
+	Ratio   Optional[float64]          `toml:"ratio,omitempty"`
+	Since   Optional[time.Time]        `toml:"since,omitempty"`
+	Tags    Optional[[]string]         `toml:"tags,omitempty"`
+	Limits  Optional[map[string]int]   `toml:"limits,omitempty"`
+	Timeout Optional[textTOMLDuration] `toml:"timeout,omitempty"`
+}
+
+// textTOMLDuration checks that TextUnmarshaler element types decode from TOML strings.
+type textTOMLDuration struct{ time.Duration }
+
+func (d *textTOMLDuration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	d.Duration = v
+	return err
+}
+
+func (d textTOMLDuration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func TestMarshalTOMLBurntSushi(t *testing.T) {
+	cfg := tomlConfig{
+		Host:    New("a\"b"),
+		Port:    New(8080),
+		Ratio:   New(1.0),
+		Since:   New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Tags:    New([]string{"x", "y"}),
+		Limits:  New(map[string]int{"max conn": 10, "min": 1}),
+		Timeout: New(textTOMLDuration{time.Minute}),
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(cfg); err != nil {
+		t.Fatalf("Encode: unexpected error: %v", err)
+	}
+
+	want := `host = "a\"b"
+port = 8080
+ratio = 1.0
+since = 2024-01-02T03:04:05Z
+tags = ["x", "y"]
+limits = { "max conn" = 10, min = 1 }
+timeout = "1m0s"
+`
+	if got := buf.String(); got != want {
+		t.Fatalf("Encode: got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMarshalTOMLFloats(t *testing.T) {
+	type point struct {
+		X float64 `json:"x"`
+		N int     `json:"n"`
+	}
+	cases := []struct {
+		name string
+		got  func() ([]byte, error)
+		want string
+	}{
+		{"float32", New(float32(2)).MarshalTOML, "2.0"},
+		{"fraction", New(0.5).MarshalTOML, "0.5"},
+		{"exponent", New(1e21).MarshalTOML, "1e+21"},
+		{"slice", New([]float64{1, 2.5}).MarshalTOML, "[1.0, 2.5]"},
+		{"map", New(map[string]*float64{"a": new(float64)}).MarshalTOML, "{ a = 0.0 }"},
+		{"struct", New(point{X: 3, N: 3}).MarshalTOML, "{ x = 3.0, n = 3 }"},
+		{"nested optional", New([]Optional[float64]{New(4.0)}).MarshalTOML, "[4.0]"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.got()
+			if err != nil {
+				t.Fatalf("MarshalTOML: unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("MarshalTOML: got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMarshalTOMLEmptyRequiresOmitempty(t *testing.T) {
+	var s struct {
+		Port Optional[int] `toml:"port"`
+	}
+	if err := toml.NewEncoder(&bytes.Buffer{}).Encode(s); err == nil {
+		t.Fatalf("Encode: expected error for empty Optional without omitempty")
+	}
+}
+
+func TestUnmarshalTOMLBurntSushi(t *testing.T) {
+	doc := `
+host = "localhost"
+ratio = 2
+since = 2024-01-02T03:04:05Z
+tags = ["x"]
+limits = { min = 1 }
+timeout = "5s"
+`
+	cfg := tomlConfig{Port: New(1)}
+	if _, err := toml.Decode(doc, &cfg); err != nil {
+		t.Fatalf("Decode: unexpected error: %v", err)
+	}
+
+	if v, ok := cfg.Host.Get(); !ok || v != "localhost" {
+		t.Fatalf("Host: got (v=%q, ok=%v), want (\"localhost\", true)", v, ok)
+	}
+	if v, ok := cfg.Port.Get(); !ok || v != 1 {
+		t.Fatalf("Port: missing key should leave the Optional untouched: got (v=%v, ok=%v)", v, ok)
+	}
+	if v, ok := cfg.Ratio.Get(); !ok || v != 2 {
+		t.Fatalf("Ratio: got (v=%v, ok=%v), want (2, true)", v, ok)
+	}
+	if v, ok := cfg.Since.Get(); !ok || !v.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Fatalf("Since: got (v=%v, ok=%v)", v, ok)
+	}
+	if v, ok := cfg.Tags.Get(); !ok || len(v) != 1 || v[0] != "x" {
+		t.Fatalf("Tags: got (v=%v, ok=%v), want ([x], true)", v, ok)
+	}
+	if v, ok := cfg.Limits.Get(); !ok || v["min"] != 1 {
+		t.Fatalf("Limits: got (v=%v, ok=%v), want (map[min:1], true)", v, ok)
+	}
+	if v, ok := cfg.Timeout.Get(); !ok || v.Duration != 5*time.Second {
+		t.Fatalf("Timeout: got (v=%v, ok=%v), want (5s, true)", v, ok)
+	}
+}
+
+func TestUnmarshalTOMLInvalid(t *testing.T) {
+	var cfg tomlConfig
+	if _, err := toml.Decode(`port = "x"`, &cfg); err == nil {
+		t.Fatalf("Decode: expected error for invalid value")
+	}
+}
+
+func TestTOMLPelletier(t *testing.T) {
+	var buf bytes.Buffer
+	enc := pelletier.NewEncoder(&buf).EnableMarshalerInterface()
+	if err := enc.Encode(tomlConfig{Port: New(8080)}); err != nil {
+		t.Fatalf("Encode: unexpected error: %v", err)
+	}
+	if got, want := buf.String(), "port = 8080\n"; got != want {
+		t.Fatalf("Encode: got %q, want %q", got, want)
+	}
+
+	var cfg tomlConfig
+	if err := pelletier.Unmarshal([]byte("host = 'h'\nport = 3\n"), &cfg); err != nil {
+		t.Fatalf("Unmarshal: unexpected error: %v", err)
+	}
+	if v, ok := cfg.Host.Get(); !ok || v != "h" {
+		t.Fatalf("Host: got (v=%q, ok=%v), want (\"h\", true)", v, ok)
+	}
+	if v, ok := cfg.Port.Get(); !ok || v != 3 {
+		t.Fatalf("Port: got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+	if !cfg.Ratio.IsEmpty() {
+		t.Fatalf("Ratio: missing key should leave the Optional empty")
+	}
+}
+
+// TestTOMLPelletierDefaultMarshal pins the behaviour of pelletier.Marshal
+// without EnableMarshalerInterface, which goes through MarshalText.
+func TestTOMLPelletierDefaultMarshal(t *testing.T) {
+	data, err := pelletier.Marshal(tomlConfig{Port: New(8080), Ratio: New(1.5)})
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+	if got, want := string(data), "port = '8080'\nratio = '1.5'\n"; got != want {
+		t.Fatalf("Marshal: got %q, want %q", got, want)
+	}
+
+	var cfg tomlConfig
+	if err := pelletier.Unmarshal(data, &cfg); err != nil {
+		t.Fatalf("Unmarshal: unexpected error: %v", err)
+	}
+	if v, ok := cfg.Port.Get(); !ok || v != 8080 {
+		t.Fatalf("Port: got (v=%v, ok=%v), want (8080, true)", v, ok)
+	}
+	if v, ok := cfg.Ratio.Get(); !ok || v != 1.5 {
+		t.Fatalf("Ratio: got (v=%v, ok=%v), want (1.5, true)", v, ok)
+	}
+
+	var plain struct {
+		Port int `toml:"port"`
+	}
+	if err := pelletier.Unmarshal(data, &plain); err == nil {
+		t.Fatalf("Unmarshal: expected error decoding a string into an int")
+	}
+}
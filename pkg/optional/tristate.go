@@ -0,0 +1,101 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type triState uint8
+
+const (
+	stateUndefined triState = iota
+	stateNull
+	stateValue
+)
+
+// TriState distinguishes a field that was not sent at all (Undefined) from
+// one sent as null (Null) and one sent with a value, as needed by JSON
+// PATCH-style APIs. The zero value is Undefined.
+type TriState[T any] struct {
+	value T
+	state triState
+}
+
+// Undefined returns a TriState that was not set at all.
+func Undefined[T any]() TriState[T] {
+	return TriState[T]{}
+}
+
+// Null returns a TriState that was explicitly set to null.
+func Null[T any]() TriState[T] {
+	return TriState[T]{state: stateNull}
+}
+
+// Value returns a TriState holding value.
+func Value[T any](value T) TriState[T] {
+	return TriState[T]{value: value, state: stateValue}
+}
+
+// TriStateFromOptional converts o into a TriState.
+// An empty Optional becomes Null, a present one a Value.
+func TriStateFromOptional[T any](o Optional[T]) TriState[T] {
+	if !o.hasValue {
+		return Null[T]()
+	}
+	return Value(o.value)
+}
+
+func (s TriState[T]) IsUndefined() bool {
+	return s.state == stateUndefined
+}
+
+func (s TriState[T]) IsNull() bool {
+	return s.state == stateNull
+}
+
+func (s TriState[T]) IsValue() bool {
+	return s.state == stateValue
+}
+
+// Get returns the value and whether the TriState holds one.
+func (s TriState[T]) Get() (T, bool) {
+	return s.value, s.state == stateValue
+}
+
+// Optional converts the TriState into an Optional.
+// Both Undefined and Null become an empty Optional.
+func (s TriState[T]) Optional() Optional[T] {
+	return FromOk(s.Get())
+}
+
+// IsZero reports whether the TriState is Undefined.
+// It lets encoding/json omit undefined fields tagged with omitzero.
+func (s TriState[T]) IsZero() bool {
+	return s.state == stateUndefined
+}
+
+// MarshalJSON implements json.Marshaler.
+// Null and Undefined are both encoded as JSON null; tag the field with
+// omitzero to leave Undefined fields out.
+func (s TriState[T]) MarshalJSON() ([]byte, error) {
+	if s.state != stateValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// encoding/json only calls it for fields that are present, so missing
+// fields stay Undefined, null becomes Null and anything else a Value.
+func (s *TriState[T]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		*s = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = Value(v)
+	return nil
+}
@@ -0,0 +1,85 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type triStatePatch struct {
+	Name  TriState[string] `json:"name,omitzero"`
+	Email TriState[string] `json:"email,omitzero"`
+	Age   TriState[int]    `json:"age,omitzero"`
+}
+
+func TestTriStateConstructors(t *testing.T) {
+	var zero TriState[int]
+	if !zero.IsUndefined() || zero.IsNull() || zero.IsValue() {
+		t.Fatalf("zero value should be Undefined")
+	}
+	if s := Undefined[int](); !s.IsUndefined() {
+		t.Fatalf("Undefined() should be Undefined")
+	}
+	if s := Null[int](); !s.IsNull() || s.IsUndefined() || s.IsValue() {
+		t.Fatalf("Null() should be Null")
+	}
+	s := Value(0)
+	if !s.IsValue() || s.IsNull() || s.IsUndefined() {
+		t.Fatalf("Value() should be a Value")
+	}
+	if v, ok := s.Get(); !ok || v != 0 {
+		t.Fatalf("Get: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+}
+
+func TestTriStateOptionalConversion(t *testing.T) {
+	if s := TriStateFromOptional(Empty[int]()); !s.IsNull() {
+		t.Fatalf("TriStateFromOptional(empty) should be Null")
+	}
+	if v, ok := TriStateFromOptional(New(2)).Get(); !ok || v != 2 {
+		t.Fatalf("TriStateFromOptional(present): got (v=%v, ok=%v), want (2, true)", v, ok)
+	}
+
+	if o := Undefined[int]().Optional(); !o.IsEmpty() {
+		t.Fatalf("Undefined.Optional() should be empty")
+	}
+	if o := Null[int]().Optional(); !o.IsEmpty() {
+		t.Fatalf("Null.Optional() should be empty")
+	}
+	if v, ok := Value(3).Optional().Get(); !ok || v != 3 {
+		t.Fatalf("Value.Optional(): got (v=%v, ok=%v), want (3, true)", v, ok)
+	}
+}
+
+func TestTriStateUnmarshalJSON(t *testing.T) {
+	var p triStatePatch
+	if err := json.Unmarshal([]byte(`{"email": null, "age": 42}`), &p); err != nil {
+		t.Fatalf("json.Unmarshal: unexpected error: %v", err)
+	}
+
+	if !p.Name.IsUndefined() {
+		t.Fatalf("Name: missing field should be Undefined")
+	}
+	if !p.Email.IsNull() {
+		t.Fatalf("Email: null field should be Null")
+	}
+	if v, ok := p.Age.Get(); !ok || v != 42 {
+		t.Fatalf("Age: got (v=%v, ok=%v), want (42, true)", v, ok)
+	}
+}
+
+func TestTriStateUnmarshalJSONInvalid(t *testing.T) {
+	var p triStatePatch
+	if err := json.Unmarshal([]byte(`{"age": "x"}`), &p); err == nil {
+		t.Fatalf("json.Unmarshal: expected error for invalid value")
+	}
+}
+
+func TestTriStateMarshalJSON(t *testing.T) {
+	got, err := json.Marshal(triStatePatch{Email: Null[string](), Age: Value(0)})
+	if err != nil {
+		t.Fatalf("json.Marshal: unexpected error: %v", err)
+	}
+	if want := `{"email":null,"age":0}`; string(got) != want {
+		t.Fatalf("json.Marshal: got %s, want %s", got, want)
+	}
+}
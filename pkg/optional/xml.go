@@ -0,0 +1,52 @@
+package optional
+
+import (
+	"encoding/xml"
+
+	"github.com/Palladium-blockchain/go-optional/internal/textconv"
+)
+
+// MarshalXML implements xml.Marshaler.
+// Empty optionals produce no element at all.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.hasValue {
+		return nil
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It is only called for elements that are present, so a missing element
+// leaves the optional untouched.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v T
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// Empty optionals produce no attribute; present values are formatted the
+// same way as MarshalText.
+func (o Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.hasValue {
+		return xml.Attr{}, nil
+	}
+	text, err := textconv.Format(o.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var v T
+	if err := textconv.Parse(&v, attr.Value); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
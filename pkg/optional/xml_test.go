@@ -0,0 +1,65 @@
+package optional
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlItem struct {
+	XMLName xml.Name         `xml:"item"`
+	ID      Optional[int]    `xml:"id,attr"`
+	Name    Optional[string] `xml:"name"`
+	Count   Optional[int]    `xml:"count"`
+}
+
+func TestMarshalXML(t *testing.T) {
+	cases := []struct {
+		name string
+		in   xmlItem
+		want string
+	}{
+		{name: "all empty", in: xmlItem{}, want: `<item></item>`},
+		{
+			name: "all present",
+			in:   xmlItem{ID: New(1), Name: New("a&b"), Count: New(0)},
+			want: `<item id="1"><name>a&amp;b</name><count>0</count></item>`,
+		},
+		{name: "partially present", in: xmlItem{Count: New(2)}, want: `<item><count>2</count></item>`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := xml.Marshal(tc.in)
+			if err != nil {
+				t.Fatalf("xml.Marshal: unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("xml.Marshal: got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	var got xmlItem
+	if err := xml.Unmarshal([]byte(`<item id="4"><name></name></item>`), &got); err != nil {
+		t.Fatalf("xml.Unmarshal: unexpected error: %v", err)
+	}
+
+	if v, ok := got.ID.Get(); !ok || v != 4 {
+		t.Fatalf("ID: got (v=%v, ok=%v), want (4, true)", v, ok)
+	}
+	if v, ok := got.Name.Get(); !ok || v != "" {
+		t.Fatalf("Name: got (v=%q, ok=%v), want (\"\", true)", v, ok)
+	}
+	if !got.Count.IsEmpty() {
+		t.Fatalf("Count: missing element should leave the Optional empty")
+	}
+}
+
+func TestUnmarshalXMLInvalidAttr(t *testing.T) {
+	var got xmlItem
+	if err := xml.Unmarshal([]byte(`<item id="x"></item>`), &got); err == nil {
+		t.Fatalf("xml.Unmarshal: expected error for invalid attribute")
+	}
+}
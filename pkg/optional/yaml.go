@@ -0,0 +1,24 @@
+package optional
+
+// MarshalYAML implements the yaml.Marshaler interface shared by
+// gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+// Empty optionals are encoded as YAML null.
+func (o Optional[T]) MarshalYAML() (any, error) {
+	if !o.hasValue {
+		return nil, nil
+	}
+	return o.value, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler interface, which
+// yaml.v3 still honors. The YAML libraries do not call unmarshalers for
+// null values, so both null and a missing key leave the optional untouched,
+// i.e. empty when decoding into a fresh struct.
+func (o *Optional[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	*o = FromPtr(v)
+	return nil
+}
@@ -0,0 +1,67 @@
+package optional
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+var _ yaml.Marshaler = Optional[int]{}
+
+type yamlConfig struct {
+	Host  Optional[string] `yaml:"host"`
+	Port  Optional[int]    `yaml:"port"`
+	Debug Optional[bool]   `yaml:"debug"`
+}
+
+func TestMarshalYAML(t *testing.T) {
+	got, err := yaml.Marshal(yamlConfig{Host: New("localhost"), Debug: New(false)})
+	if err != nil {
+		t.Fatalf("yaml.Marshal: unexpected error: %v", err)
+	}
+
+	want := "host: localhost\nport: null\ndebug: false\n"
+	if string(got) != want {
+		t.Fatalf("yaml.Marshal: got %q, want %q", got, want)
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	cfg := yamlConfig{Host: New("keep")}
+	if err := yaml.Unmarshal([]byte("port: null\ndebug: true\n"), &cfg); err != nil {
+		t.Fatalf("yaml.Unmarshal: unexpected error: %v", err)
+	}
+
+	if v, ok := cfg.Host.Get(); !ok || v != "keep" {
+		t.Fatalf("Host: missing key should leave the Optional untouched: got (v=%q, ok=%v)", v, ok)
+	}
+	if !cfg.Port.IsEmpty() {
+		t.Fatalf("Port: null should leave the Optional empty")
+	}
+	if v, ok := cfg.Debug.Get(); !ok || !v {
+		t.Fatalf("Debug: got (v=%v, ok=%v), want (true, true)", v, ok)
+	}
+}
+
+func TestUnmarshalYAMLRoundTrip(t *testing.T) {
+	in := yamlConfig{Host: New(""), Port: New(8080)}
+	data, err := yaml.Marshal(in)
+	if err != nil {
+		t.Fatalf("yaml.Marshal: unexpected error: %v", err)
+	}
+
+	var out yamlConfig
+	if err := yaml.Unmarshal(data, &out); err != nil {
+		t.Fatalf("yaml.Unmarshal: unexpected error: %v", err)
+	}
+	if out != in {
+		t.Fatalf("round trip: got %+v, want %+v", out, in)
+	}
+}
+
+func TestUnmarshalYAMLInvalid(t *testing.T) {
+	var cfg yamlConfig
+	if err := yaml.Unmarshal([]byte("port: abc\n"), &cfg); err == nil {
+		t.Fatalf("yaml.Unmarshal: expected error for invalid value")
+	}
+}
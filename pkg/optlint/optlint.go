@@ -0,0 +1,195 @@
+// Package optlint defines an analyzer that reports common misuse of
+// optional.Optional:
+//
+//   - discarding the ok result of Get, as in v, _ := o.Get(), which silently
+//     turns an empty Optional into the zero value;
+//   - declaring a *Optional[T] parameter that the function never modifies,
+//     where passing the Optional by value would do.
+//
+// Run it on its own with cmd/optlint, or through go vet:
+//
+//	go vet -vettool=$(which optlint) ./...
+package optlint
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const optionalPath = "github.com/Palladium-blockchain/go-optional/pkg/optional"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "optlint",
+	Doc:      "report common misuse of optional.Optional",
+	URL:      "https://pkg.go.dev/github.com/Palladium-blockchain/go-optional/pkg/optlint",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	filter := []ast.Node{(*ast.AssignStmt)(nil), (*ast.ValueSpec)(nil), (*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}
+	insp.Preorder(filter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 2 && len(n.Rhs) == 1 {
+				checkIgnoredOk(pass, n.Lhs[1], n.Rhs[0])
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) == 2 && len(n.Values) == 1 {
+				checkIgnoredOk(pass, n.Names[1], n.Values[0])
+			}
+		case *ast.FuncDecl:
+			if n.Body != nil {
+				checkPointerParams(pass, n.Type, n.Body)
+			}
+		case *ast.FuncLit:
+			checkPointerParams(pass, n.Type, n.Body)
+		}
+	})
+	return nil, nil
+}
+
+// isOptional reports whether t is an instance of optional.Optional.
+func isOptional(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == optionalPath && obj.Name() == "Optional"
+}
+
+// isOptionalPointer reports whether t is *optional.Optional[T].
+func isOptionalPointer(t types.Type) bool {
+	ptr, ok := types.Unalias(t).(*types.Pointer)
+	return ok && isOptional(ptr.Elem())
+}
+
+func checkIgnoredOk(pass *analysis.Pass, ok ast.Expr, rhs ast.Expr) {
+	if id, isIdent := ok.(*ast.Ident); !isIdent || id.Name != "_" {
+		return
+	}
+	call, isCall := ast.Unparen(rhs).(*ast.CallExpr)
+	if !isCall {
+		return
+	}
+	sel, isSel := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !isSel || sel.Sel.Name != "Get" {
+		return
+	}
+	if recv := pass.TypesInfo.TypeOf(sel.X); recv == nil || !(isOptional(recv) || isOptionalPointer(recv)) {
+		return
+	}
+	pass.Reportf(call.Pos(), "ok result of Optional.Get is ignored; check it or use Or, MustGet or Expect")
+}
+
+// checkPointerParams reports *Optional[T] parameters of a function that are
+// only ever read.
+func checkPointerParams(pass *analysis.Pass, ft *ast.FuncType, body *ast.BlockStmt) {
+	for _, field := range ft.Params.List {
+		for _, name := range field.Names {
+			obj, ok := pass.TypesInfo.Defs[name].(*types.Var)
+			if !ok || name.Name == "_" || !isOptionalPointer(obj.Type()) {
+				continue
+			}
+			if onlyRead(pass, body, obj) {
+				pass.Reportf(name.Pos(), "parameter %s is a *Optional but is never modified; pass the Optional by value", name.Name)
+			}
+		}
+	}
+}
+
+// onlyRead reports whether every use of the pointer p in body is a call to
+// a value-receiver method or a dereference that is not assigned to.
+func onlyRead(pass *analysis.Pass, body *ast.BlockStmt, p *types.Var) bool {
+	used, readOnly := false, true
+	var stack []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+		id, ok := n.(*ast.Ident)
+		if !ok || pass.TypesInfo.Uses[id] != p {
+			return true
+		}
+		used = true
+		if !isReadUse(pass, stack) {
+			readOnly = false
+		}
+		return true
+	})
+	return used && readOnly
+}
+
+// isReadUse reports whether the use of the pointer at the top of stack
+// cannot modify the Optional it points to: a call to a value-receiver
+// method, or a dereference that is only read.
+func isReadUse(pass *analysis.Pass, stack []ast.Node) bool {
+	i := parentIndex(stack)
+	switch parent := stack[i].(type) {
+	case *ast.SelectorExpr:
+		return isValueMethod(pass, parent)
+	case *ast.StarExpr:
+		return isReadDeref(pass, stack[:i+1])
+	}
+	return false
+}
+
+// isReadDeref reports whether the dereference at the top of stack is only
+// read: not assigned to, incremented, addressed, or used to call a
+// pointer-receiver method.
+func isReadDeref(pass *analysis.Pass, stack []ast.Node) bool {
+	i := parentIndex(stack)
+	if i < 0 {
+		return true
+	}
+	child := stack[i+1]
+	switch parent := stack[i].(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range parent.Lhs {
+			if lhs == child {
+				return false
+			}
+		}
+	case *ast.IncDecStmt:
+		return false
+	case *ast.UnaryExpr:
+		return parent.Op != token.AND
+	case *ast.SelectorExpr:
+		return isValueMethod(pass, parent)
+	}
+	return true
+}
+
+// parentIndex returns the index in stack of the parent of the node at the
+// top, skipping parentheses, or -1 if there is none.
+func parentIndex(stack []ast.Node) int {
+	i := len(stack) - 2
+	for i >= 0 {
+		if _, ok := stack[i].(*ast.ParenExpr); !ok {
+			break
+		}
+		i--
+	}
+	return i
+}
+
+// isValueMethod reports whether sel selects a method with a value receiver.
+func isValueMethod(pass *analysis.Pass, sel *ast.SelectorExpr) bool {
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return false
+	}
+	recv := selection.Obj().(*types.Func).Signature().Recv().Type()
+	_, ptrRecv := recv.(*types.Pointer)
+	return !ptrRecv
+}
@@ -0,0 +1,12 @@
+package optlint_test
+
+import (
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optlint"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), optlint.Analyzer, "a")
+}
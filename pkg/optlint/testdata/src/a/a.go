@@ -0,0 +1,66 @@
+package a
+
+import "github.com/Palladium-blockchain/go-optional/pkg/optional"
+
+type Name = optional.Optional[string]
+
+func ignoredOk(o optional.Optional[int], p *optional.Optional[int], n Name) {
+	v, _ := o.Get()    // want `ok result of Optional.Get is ignored`
+	_, _ = p.Get()     // want `ok result of Optional.Get is ignored`
+	var s, _ = n.Get() // want `ok result of Optional.Get is ignored`
+	_, _, _ = v, s, n
+	p.Set(0)
+
+	if v, ok := o.Get(); ok {
+		_ = v
+	}
+	m := map[string]int{}
+	x, _ := m["a"]
+	_ = x
+}
+
+// Unset zeroes the value, so == and != are sound for comparable T.
+func comparisons(a, b optional.Optional[int]) bool {
+	if a == b {
+		return true
+	}
+	if a != optional.New(1) {
+		return false
+	}
+	return optional.Equal(a, b)
+}
+
+func readOnly(p *optional.Optional[int]) int { // want `parameter p is a \*Optional but is never modified`
+	if p.IsEmpty() {
+		return 0
+	}
+	return (*p).Or(1)
+}
+
+func readDeref(p *optional.Optional[int]) optional.Optional[int] { // want `parameter p is a \*Optional but is never modified`
+	return *p
+}
+
+func sets(p *optional.Optional[int]) {
+	p.Set(1)
+}
+
+func assigns(p *optional.Optional[int]) {
+	*p = optional.New(2)
+}
+
+func forwards(p *optional.Optional[int]) {
+	sets(p)
+}
+
+func derefSet(p *optional.Optional[int]) {
+	(*p).Set(3)
+}
+
+func unused(p *optional.Optional[int]) {}
+
+func literal() {
+	_ = func(p *optional.Optional[string]) bool { // want `parameter p is a \*Optional but is never modified`
+		return p.IsEmpty()
+	}
+}
@@ -0,0 +1,23 @@
+// Package optional is a minimal stand-in for the real package, enough for
+// the analyzer tests to type-check.
+package optional
+
+type Optional[T any] struct {
+	value    T
+	hasValue bool
+}
+
+func New[T any](value T) Optional[T] { return Optional[T]{value: value, hasValue: true} }
+
+func (o Optional[T]) Get() (T, bool) { return o.value, o.hasValue }
+func (o Optional[T]) IsEmpty() bool  { return !o.hasValue }
+func (o Optional[T]) Or(v T) T {
+	if o.hasValue {
+		return o.value
+	}
+	return v
+}
+
+func (o *Optional[T]) Set(value T) { o.value, o.hasValue = value, true }
+
+func Equal[T comparable](a, b Optional[T]) bool { return a == b }
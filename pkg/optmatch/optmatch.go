@@ -0,0 +1,131 @@
+// Package optmatch provides argument matchers for optional.Optional and
+// optional.TriState values, so expectations on mocks of services taking
+// Optional arguments read as Some(42) or None() instead of hand-written
+// predicates.
+//
+// A Matcher satisfies the go.uber.org/mock/gomock.Matcher interface and can
+// be passed directly to generated EXPECT calls:
+//
+//	svc.EXPECT().Update(optmatch.Some("alice"), optmatch.None())
+//
+// With github.com/stretchr/testify, wrap its Matches method in
+// mock.MatchedBy, or assert on it directly:
+//
+//	m.On("Update", mock.MatchedBy(optmatch.Some("alice").Matches))
+//	assert.True(t, optmatch.None().Matches(got))
+package optmatch
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// Matcher reports whether an argument matches an expectation.
+type Matcher struct {
+	match func(x any) bool
+	desc  string
+}
+
+// Matches reports whether x matches.
+func (m Matcher) Matches(x any) bool {
+	return m.match(x)
+}
+
+// String describes what the matcher matches, for failure messages.
+func (m Matcher) String() string {
+	return m.desc
+}
+
+// Some returns a Matcher for Optionals and TriStates holding a value that
+// matches x. If x has a Matches(any) bool method, such as a gomock matcher,
+// it is used to match the value; otherwise the value must be deeply equal
+// to x, except that numbers of different types match if they print the
+// same, so Some(3) matches an Optional[int64] holding 3.
+func Some(x any) Matcher {
+	inner, ok := x.(interface{ Matches(any) bool })
+	if !ok {
+		inner = eqMatcher{x}
+	}
+	return Matcher{
+		match: func(arg any) bool {
+			v, present, ok := get(arg)
+			return ok && present && inner.Matches(v)
+		},
+		desc: fmt.Sprintf("is Some(%v)", x),
+	}
+}
+
+// None returns a Matcher for empty Optionals and for TriStates that are
+// Undefined or Null.
+func None() Matcher {
+	return Matcher{
+		match: func(arg any) bool {
+			_, present, ok := get(arg)
+			return ok && !present
+		},
+		desc: "is None",
+	}
+}
+
+// Eq returns a Matcher for Optionals of the same type as want that are
+// empty if want is empty and otherwise hold a value deeply equal to the
+// value of want.
+func Eq[T any](want optional.Optional[T]) Matcher {
+	return Matcher{
+		match: func(arg any) bool {
+			if p, ok := arg.(*optional.Optional[T]); ok && p != nil {
+				arg = *p
+			}
+			got, ok := arg.(optional.Optional[T])
+			return ok && reflect.DeepEqual(got, want)
+		},
+		desc: fmt.Sprintf("is equal to %v", want),
+	}
+}
+
+var optionalPkgPath = reflect.TypeFor[optional.Optional[int]]().PkgPath()
+
+// get returns the value held by arg and whether it is present, or false
+// if arg is not an Optional, a TriState or a non-nil pointer to one.
+func get(arg any) (value any, present, ok bool) {
+	rv := reflect.ValueOf(arg)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || rv.Type().PkgPath() != optionalPkgPath {
+		return nil, false, false
+	}
+	if name := rv.Type().Name(); !strings.HasPrefix(name, "Optional[") && !strings.HasPrefix(name, "TriState[") {
+		return nil, false, false
+	}
+	out := rv.MethodByName("Get").Call(nil)
+	return out[0].Interface(), out[1].Bool(), true
+}
+
+// eqMatcher matches values deeply equal to want.
+type eqMatcher struct {
+	want any
+}
+
+func (m eqMatcher) Matches(x any) bool {
+	if reflect.DeepEqual(m.want, x) {
+		return true
+	}
+	// Numbers of different types match if they print the same, so 3
+	// matches int64(3) and 2.5 matches float32(2.5), but -1 does not match
+	// the uint it would convert to.
+	return isNumeric(m.want) && isNumeric(x) && fmt.Sprint(m.want) == fmt.Sprint(x)
+}
+
+func isNumeric(x any) bool {
+	switch reflect.ValueOf(x).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
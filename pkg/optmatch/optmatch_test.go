@@ -0,0 +1,108 @@
+package optmatch
+
+import (
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMatchers(t *testing.T) {
+	three := optional.New(3)
+	cases := []struct {
+		name    string
+		matcher Matcher
+		arg     any
+		want    bool
+	}{
+		{name: "some", matcher: Some(3), arg: three, want: true},
+		{name: "some other value", matcher: Some(4), arg: three, want: false},
+		{name: "some empty", matcher: Some(3), arg: optional.Empty[int](), want: false},
+		{name: "some pointer", matcher: Some(3), arg: &three, want: true},
+		{name: "some converted", matcher: Some(3), arg: optional.New[int64](3), want: true},
+		{name: "some negative unsigned", matcher: Some(-1), arg: optional.New(^uint(0)), want: false},
+		{name: "some struct", matcher: Some([]string{"a"}), arg: optional.New([]string{"a"}), want: true},
+		{name: "some nested matcher", matcher: Some(gomock.Any()), arg: optional.New("x"), want: true},
+		{name: "some tristate", matcher: Some("x"), arg: optional.Value("x"), want: true},
+		{name: "some not optional", matcher: Some(3), arg: 3, want: false},
+		{name: "none", matcher: None(), arg: optional.Empty[int](), want: true},
+		{name: "none present", matcher: None(), arg: three, want: false},
+		{name: "none null", matcher: None(), arg: optional.Null[int](), want: true},
+		{name: "none undefined", matcher: None(), arg: optional.Undefined[int](), want: true},
+		{name: "none nil pointer", matcher: None(), arg: (*optional.Optional[int])(nil), want: false},
+		{name: "none not optional", matcher: None(), arg: nil, want: false},
+		{name: "eq", matcher: Eq(three), arg: three, want: true},
+		{name: "eq empty", matcher: Eq(optional.Empty[int]()), arg: optional.Empty[int](), want: true},
+		{name: "eq mismatch", matcher: Eq(three), arg: optional.Empty[int](), want: false},
+		{name: "eq other type", matcher: Eq(three), arg: optional.New[int64](3), want: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.matcher.Matches(tc.arg); got != tc.want {
+				t.Fatalf("%v.Matches(%v): got %v, want %v", tc.matcher, tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	for _, tc := range []struct {
+		matcher Matcher
+		want    string
+	}{
+		{Some(3), "is Some(3)"},
+		{Some(gomock.Any()), "is Some(is anything)"},
+		{None(), "is None"},
+		{Eq(optional.New("a")), "is equal to Some(a)"},
+	} {
+		if got := tc.matcher.String(); got != tc.want {
+			t.Fatalf("String: got %q, want %q", got, tc.want)
+		}
+	}
+}
+
+type userService interface {
+	Update(id int, name optional.Optional[string], age optional.Optional[int]) error
+}
+
+type mockUserService struct {
+	ctrl *gomock.Controller
+}
+
+func (m *mockUserService) Update(id int, name optional.Optional[string], age optional.Optional[int]) error {
+	err, _ := m.ctrl.Call(m, "Update", id, name, age)[0].(error)
+	return err
+}
+
+func TestGomock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mockUserService{ctrl: ctrl}
+	ctrl.RecordCall(m, "Update", 1, Some("alice"), None()).Return(nil)
+
+	var svc userService = m
+	if err := svc.Update(1, optional.New("alice"), optional.Empty[int]()); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+}
+
+type testifyUserService struct {
+	mock.Mock
+}
+
+func (m *testifyUserService) Update(id int, name optional.Optional[string], age optional.Optional[int]) error {
+	return m.Called(id, name, age).Error(0)
+}
+
+func TestTestify(t *testing.T) {
+	m := new(testifyUserService)
+	m.On("Update", 1, mock.MatchedBy(Some("alice").Matches), mock.MatchedBy(Eq(optional.New(30)).Matches)).Return(nil)
+
+	var svc userService = m
+	if err := svc.Update(1, optional.New("alice"), optional.New(30)); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	m.AssertExpectations(t)
+	assert.True(t, None().Matches(optional.Empty[string]()))
+}
@@ -0,0 +1,14 @@
+package optpb
+
+import (
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FieldMask returns a FieldMask listing the paths of the present Optional
+// fields of patch, as computed by optional.FieldPaths, so update requests
+// can be built directly from patch structs. Path elements follow the
+// name= option of protobuf struct tags, then json tags.
+func FieldMask(patch any) *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{Paths: optional.FieldPaths(patch)}
+}
@@ -0,0 +1,24 @@
+package optpb
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+type userPatch struct {
+	DisplayName optional.Optional[string] `protobuf:"bytes,1,opt,name=display_name,json=displayName"`
+	Email       optional.Optional[string] `json:"email"`
+	Age         optional.Optional[int32]  `json:"age"`
+}
+
+func TestFieldMask(t *testing.T) {
+	m := FieldMask(userPatch{DisplayName: optional.New("Ann"), Age: optional.New[int32](0)})
+	if want := []string{"display_name", "age"}; !slices.Equal(m.GetPaths(), want) {
+		t.Fatalf("FieldMask: got %q, want %q", m.GetPaths(), want)
+	}
+	if m := FieldMask(userPatch{}); len(m.GetPaths()) != 0 {
+		t.Fatalf("FieldMask(empty): got %q, want no paths", m.GetPaths())
+	}
+}
@@ -0,0 +1,179 @@
+// Package optpb converts between optional.Optional and the protobuf
+// well-known wrapper types in google.golang.org/protobuf/types/known/wrapperspb.
+package optpb
+
+import (
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// FromStringValue converts a *wrapperspb.StringValue into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromStringValue(w *wrapperspb.StringValue) optional.Optional[string] {
+	if w == nil {
+		return optional.Empty[string]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToStringValue converts an Optional into a *wrapperspb.StringValue.
+// An empty Optional yields nil.
+func ToStringValue(o optional.Optional[string]) *wrapperspb.StringValue {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.String(v)
+}
+
+// FromBoolValue converts a *wrapperspb.BoolValue into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromBoolValue(w *wrapperspb.BoolValue) optional.Optional[bool] {
+	if w == nil {
+		return optional.Empty[bool]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToBoolValue converts an Optional into a *wrapperspb.BoolValue.
+// An empty Optional yields nil.
+func ToBoolValue(o optional.Optional[bool]) *wrapperspb.BoolValue {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Bool(v)
+}
+
+// FromInt32Value converts a *wrapperspb.Int32Value into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromInt32Value(w *wrapperspb.Int32Value) optional.Optional[int32] {
+	if w == nil {
+		return optional.Empty[int32]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToInt32Value converts an Optional into a *wrapperspb.Int32Value.
+// An empty Optional yields nil.
+func ToInt32Value(o optional.Optional[int32]) *wrapperspb.Int32Value {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Int32(v)
+}
+
+// FromInt64Value converts a *wrapperspb.Int64Value into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromInt64Value(w *wrapperspb.Int64Value) optional.Optional[int64] {
+	if w == nil {
+		return optional.Empty[int64]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToInt64Value converts an Optional into a *wrapperspb.Int64Value.
+// An empty Optional yields nil.
+func ToInt64Value(o optional.Optional[int64]) *wrapperspb.Int64Value {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Int64(v)
+}
+
+// FromUInt32Value converts a *wrapperspb.UInt32Value into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromUInt32Value(w *wrapperspb.UInt32Value) optional.Optional[uint32] {
+	if w == nil {
+		return optional.Empty[uint32]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToUInt32Value converts an Optional into a *wrapperspb.UInt32Value.
+// An empty Optional yields nil.
+func ToUInt32Value(o optional.Optional[uint32]) *wrapperspb.UInt32Value {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.UInt32(v)
+}
+
+// FromUInt64Value converts a *wrapperspb.UInt64Value into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromUInt64Value(w *wrapperspb.UInt64Value) optional.Optional[uint64] {
+	if w == nil {
+		return optional.Empty[uint64]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToUInt64Value converts an Optional into a *wrapperspb.UInt64Value.
+// An empty Optional yields nil.
+func ToUInt64Value(o optional.Optional[uint64]) *wrapperspb.UInt64Value {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.UInt64(v)
+}
+
+// FromFloatValue converts a *wrapperspb.FloatValue into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromFloatValue(w *wrapperspb.FloatValue) optional.Optional[float32] {
+	if w == nil {
+		return optional.Empty[float32]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToFloatValue converts an Optional into a *wrapperspb.FloatValue.
+// An empty Optional yields nil.
+func ToFloatValue(o optional.Optional[float32]) *wrapperspb.FloatValue {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Float(v)
+}
+
+// FromDoubleValue converts a *wrapperspb.DoubleValue into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromDoubleValue(w *wrapperspb.DoubleValue) optional.Optional[float64] {
+	if w == nil {
+		return optional.Empty[float64]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToDoubleValue converts an Optional into a *wrapperspb.DoubleValue.
+// An empty Optional yields nil.
+func ToDoubleValue(o optional.Optional[float64]) *wrapperspb.DoubleValue {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Double(v)
+}
+
+// FromBytesValue converts a *wrapperspb.BytesValue into an Optional.
+// A nil wrapper yields an empty Optional.
+func FromBytesValue(w *wrapperspb.BytesValue) optional.Optional[[]byte] {
+	if w == nil {
+		return optional.Empty[[]byte]()
+	}
+	return optional.New(w.GetValue())
+}
+
+// ToBytesValue converts an Optional into a *wrapperspb.BytesValue.
+// An empty Optional yields nil.
+func ToBytesValue(o optional.Optional[[]byte]) *wrapperspb.BytesValue {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Bytes(v)
+}
@@ -0,0 +1,54 @@
+package optpb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestFromValueNilIsEmpty(t *testing.T) {
+	if o := FromStringValue(nil); !o.IsEmpty() {
+		t.Fatalf("FromStringValue(nil) should be empty")
+	}
+	if o := FromInt64Value(nil); !o.IsEmpty() {
+		t.Fatalf("FromInt64Value(nil) should be empty")
+	}
+	if o := FromBytesValue(nil); !o.IsEmpty() {
+		t.Fatalf("FromBytesValue(nil) should be empty")
+	}
+}
+
+func TestFromValue(t *testing.T) {
+	if v, ok := FromStringValue(wrapperspb.String("")).Get(); !ok || v != "" {
+		t.Fatalf("FromStringValue: got (v=%q, ok=%v), want (\"\", true)", v, ok)
+	}
+	if v, ok := FromBoolValue(wrapperspb.Bool(false)).Get(); !ok || v {
+		t.Fatalf("FromBoolValue: got (v=%v, ok=%v), want (false, true)", v, ok)
+	}
+	if v, ok := FromUInt32Value(wrapperspb.UInt32(7)).Get(); !ok || v != 7 {
+		t.Fatalf("FromUInt32Value: got (v=%v, ok=%v), want (7, true)", v, ok)
+	}
+	if v, ok := FromDoubleValue(wrapperspb.Double(1.5)).Get(); !ok || v != 1.5 {
+		t.Fatalf("FromDoubleValue: got (v=%v, ok=%v), want (1.5, true)", v, ok)
+	}
+}
+
+func TestToValue(t *testing.T) {
+	if w := ToStringValue(optional.Empty[string]()); w != nil {
+		t.Fatalf("ToStringValue(empty): got %v, want nil", w)
+	}
+	if w := ToInt32Value(optional.New[int32](-3)); w == nil || w.GetValue() != -3 {
+		t.Fatalf("ToInt32Value: got %v, want value -3", w)
+	}
+	if w := ToFloatValue(optional.New[float32](0)); w == nil || w.GetValue() != 0 {
+		t.Fatalf("ToFloatValue: got %v, want value 0", w)
+	}
+	if w := ToUInt64Value(optional.New[uint64](9)); w == nil || w.GetValue() != 9 {
+		t.Fatalf("ToUInt64Value: got %v, want value 9", w)
+	}
+	if w := ToBytesValue(optional.New([]byte("b"))); w == nil || !bytes.Equal(w.GetValue(), []byte("b")) {
+		t.Fatalf("ToBytesValue: got %v, want value b", w)
+	}
+}
@@ -0,0 +1,68 @@
+// Package optpflag registers optional.Flag values with github.com/spf13/pflag
+// and adds shell completion hints for github.com/spf13/cobra commands.
+package optpflag
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Value is a pflag.Value holding an Optional. A flag that is never passed
+// stays empty while one passed as the zero value becomes present.
+type Value[T any] struct {
+	optional.Flag[T]
+}
+
+// Type implements pflag.Value. It reports the pflag type name of T, such as
+// "int", "string" or "duration".
+func (v *Value[T]) Type() string {
+	typ := reflect.TypeFor[T]()
+	if typ == reflect.TypeFor[time.Duration]() {
+		return "duration"
+	}
+	return typ.String()
+}
+
+// Var defines an Optional-valued flag on fs.
+func Var[T any](fs *pflag.FlagSet, name, usage string) *Value[T] {
+	return VarP[T](fs, name, "", usage)
+}
+
+// VarP is like Var, but accepts a shorthand letter.
+func VarP[T any](fs *pflag.FlagSet, name, shorthand, usage string) *Value[T] {
+	v := new(Value[T])
+	f := fs.VarPF(v, name, shorthand, usage)
+	if v.IsBoolFlag() {
+		f.NoOptDefVal = "true"
+	}
+	return v
+}
+
+// VarWithFallback is like Var, but shows fallback as the flag's default in
+// the help output. The value still stays empty when the flag is not passed,
+// so callers apply the fallback themselves, typically with Or(fallback).
+func VarWithFallback[T any](fs *pflag.FlagSet, name string, fallback T, usage string) *Value[T] {
+	v := Var[T](fs, name, usage)
+	var display optional.Flag[T]
+	display.Optional = optional.New(fallback)
+	fs.Lookup(name).DefValue = display.String()
+	return v
+}
+
+// Complete registers shell completion for the flag name on cmd, offering
+// candidates. Without candidates, boolean flags complete to true and false
+// and other flags only disable file name completion.
+func Complete(cmd *cobra.Command, name string, candidates ...string) error {
+	f := cmd.Flags().Lookup(name)
+	if f == nil {
+		f = cmd.PersistentFlags().Lookup(name)
+	}
+	if len(candidates) == 0 && f != nil && f.Value.Type() == "bool" {
+		candidates = []string{"true", "false"}
+	}
+	return cmd.RegisterFlagCompletionFunc(name, cobra.FixedCompletions(candidates, cobra.ShellCompDirectiveNoFileComp))
+}
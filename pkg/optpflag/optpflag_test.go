@@ -0,0 +1,104 @@
+package optpflag
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var _ pflag.Value = (*Value[int])(nil)
+
+func TestVar(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	port := VarP[int](fs, "port", "p", "listen port")
+	verbose := Var[bool](fs, "verbose", "verbose output")
+	timeout := Var[time.Duration](fs, "timeout", "timeout")
+	name := Var[string](fs, "name", "name")
+
+	if err := fs.Parse([]string{"-p", "0", "--verbose", "--timeout=3s"}); err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+
+	if v, ok := port.Get(); !ok || v != 0 {
+		t.Fatalf("port: got (v=%v, ok=%v), want (0, true)", v, ok)
+	}
+	if v, ok := verbose.Get(); !ok || !v {
+		t.Fatalf("verbose: got (v=%v, ok=%v), want (true, true)", v, ok)
+	}
+	if v, ok := timeout.Get(); !ok || v != 3*time.Second {
+		t.Fatalf("timeout: got (v=%v, ok=%v), want (3s, true)", v, ok)
+	}
+	if !name.IsEmpty() {
+		t.Fatalf("name: flag that was not passed should be empty")
+	}
+}
+
+func TestType(t *testing.T) {
+	cases := []struct {
+		got  string
+		want string
+	}{
+		{got: new(Value[int]).Type(), want: "int"},
+		{got: new(Value[string]).Type(), want: "string"},
+		{got: new(Value[time.Duration]).Type(), want: "duration"},
+	}
+	for _, tc := range cases {
+		if tc.got != tc.want {
+			t.Fatalf("Type: got %q, want %q", tc.got, tc.want)
+		}
+	}
+}
+
+func TestUsageDefaults(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	Var[int](fs, "retries", "retry count")
+	workers := VarWithFallback(fs, "workers", 4, "worker count")
+
+	usage := fs.FlagUsages()
+	if !strings.Contains(usage, "--retries int") || strings.Contains(usage, "retry count (default") {
+		t.Fatalf("empty flag should be listed without a default:\n%s", usage)
+	}
+	if !strings.Contains(usage, "worker count (default 4)") {
+		t.Fatalf("fallback should be displayed as the default:\n%s", usage)
+	}
+
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if !workers.IsEmpty() || workers.Or(4) != 4 {
+		t.Fatalf("workers: flag with fallback should stay empty when not passed")
+	}
+}
+
+func TestComplete(t *testing.T) {
+	complete := func(t *testing.T, flagName string, candidates ...string) string {
+		t.Helper()
+		cmd := &cobra.Command{Use: "app", Run: func(*cobra.Command, []string) {}}
+		Var[bool](cmd.Flags(), "verbose", "verbose output")
+		Var[string](cmd.Flags(), "format", "output format")
+		if err := Complete(cmd, flagName, candidates...); err != nil {
+			t.Fatalf("Complete: unexpected error: %v", err)
+		}
+
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(io.Discard)
+		cmd.SetArgs([]string{cobra.ShellCompRequestCmd, "--" + flagName + "="})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute: unexpected error: %v", err)
+		}
+		return out.String()
+	}
+
+	if got := complete(t, "format", "json", "yaml"); !strings.HasPrefix(got, "json\nyaml\n:4\n") {
+		t.Fatalf("format completion: got %q", got)
+	}
+	if got := complete(t, "verbose"); !strings.HasPrefix(got, "true\nfalse\n:4\n") {
+		t.Fatalf("verbose completion: got %q", got)
+	}
+}
@@ -0,0 +1,90 @@
+// Package optrapid provides pgregory.net/rapid generators for
+// optional.Optional and optional.TriState values.
+//
+// The generators shrink towards the empty Optional (and towards Undefined
+// for TriState), so a failing property is reported with as few values
+// present as possible, and then shrink the remaining values with their own
+// generators.
+package optrapid
+
+import (
+	"fmt"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"pgregory.net/rapid"
+)
+
+// coinBits is the number of bits drawn for a weighted choice, giving a
+// resolution of 1/1024.
+const coinBits = 10
+
+// Optional returns a generator of Optionals that are empty half of the time
+// and otherwise hold a value drawn from gen.
+func Optional[T any](gen *rapid.Generator[T]) *rapid.Generator[optional.Optional[T]] {
+	return Weighted(gen, 0.5)
+}
+
+// Weighted returns a generator of Optionals that are empty with probability
+// pEmpty and otherwise hold a value drawn from gen. It panics if pEmpty is
+// not in [0, 1].
+func Weighted[T any](gen *rapid.Generator[T], pEmpty float64) *rapid.Generator[optional.Optional[T]] {
+	checkProbability(pEmpty)
+	return rapid.Custom(func(t *rapid.T) optional.Optional[T] {
+		if uniform(t, "present") < pEmpty {
+			return optional.Empty[T]()
+		}
+		return optional.New(gen.Draw(t, "value"))
+	})
+}
+
+// Present returns a generator of Optionals that always hold a value drawn
+// from gen.
+func Present[T any](gen *rapid.Generator[T]) *rapid.Generator[optional.Optional[T]] {
+	return rapid.Map(gen, optional.New[T])
+}
+
+// TriState returns a generator of TriStates that are Undefined, Null or a
+// Value drawn from gen, each a third of the time.
+func TriState[T any](gen *rapid.Generator[T]) *rapid.Generator[optional.TriState[T]] {
+	return WeightedTriState(gen, 1.0/3, 1.0/3)
+}
+
+// WeightedTriState returns a generator of TriStates that are Undefined with
+// probability pUndefined, Null with probability pNull and otherwise a Value
+// drawn from gen. It panics if the probabilities are negative or add up to
+// more than 1.
+func WeightedTriState[T any](gen *rapid.Generator[T], pUndefined, pNull float64) *rapid.Generator[optional.TriState[T]] {
+	checkProbability(pUndefined)
+	checkProbability(pNull)
+	checkProbability(pUndefined + pNull)
+	return rapid.Custom(func(t *rapid.T) optional.TriState[T] {
+		switch u := uniform(t, "state"); {
+		case u < pUndefined:
+			return optional.Undefined[T]()
+		case u < pUndefined+pNull:
+			return optional.Null[T]()
+		}
+		return optional.Value(gen.Draw(t, "value"))
+	})
+}
+
+// uniform draws a number uniformly distributed in [0, 1). Unlike rapid's
+// number generators it is not biased towards edge cases, and it shrinks
+// towards 0.
+func uniform(t *rapid.T, label string) float64 {
+	bits := rapid.SliceOfN(rapid.Bool(), coinBits, coinBits).Draw(t, label)
+	var n int
+	for _, b := range bits {
+		n <<= 1
+		if b {
+			n |= 1
+		}
+	}
+	return float64(n) / (1 << coinBits)
+}
+
+func checkProbability(p float64) {
+	if !(p >= 0 && p <= 1) {
+		panic(fmt.Sprintf("optrapid: probability %v is not in [0, 1]", p))
+	}
+}
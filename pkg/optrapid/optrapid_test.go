@@ -0,0 +1,105 @@
+package optrapid
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"pgregory.net/rapid"
+)
+
+func TestOptionalDistribution(t *testing.T) {
+	cases := []struct {
+		name   string
+		gen    *rapid.Generator[optional.Optional[int]]
+		lo, hi int // expected range of empty values out of 1000
+	}{
+		{name: "optional", gen: Optional(rapid.Int()), lo: 400, hi: 600},
+		{name: "weighted", gen: Weighted(rapid.Int(), 0.1), lo: 50, hi: 150},
+		{name: "never empty", gen: Weighted(rapid.Int(), 0), lo: 0, hi: 0},
+		{name: "always empty", gen: Weighted(rapid.Int(), 1), lo: 1000, hi: 1000},
+		{name: "present", gen: Present(rapid.Int()), lo: 0, hi: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			empty := 0
+			for i := range 1000 {
+				if tc.gen.Example(i).IsEmpty() {
+					empty++
+				}
+			}
+			if empty < tc.lo || empty > tc.hi {
+				t.Fatalf("got %d empty values out of 1000, want %d..%d", empty, tc.lo, tc.hi)
+			}
+		})
+	}
+}
+
+func TestTriStateDistribution(t *testing.T) {
+	gen := WeightedTriState(rapid.String(), 0.2, 0.3)
+	var undefined, null, value int
+	for i := range 1000 {
+		s := gen.Example(i)
+		switch {
+		case s.IsUndefined():
+			undefined++
+		case s.IsNull():
+			null++
+		default:
+			value++
+		}
+	}
+	if undefined < 130 || undefined > 270 || null < 220 || null > 380 || value < 420 || value > 580 {
+		t.Fatalf("got %d undefined, %d null and %d values out of 1000", undefined, null, value)
+	}
+}
+
+func TestShrinksTowardsEmpty(t *testing.T) {
+	if err := flag.Set("rapid.nofailfile", "true"); err != nil {
+		t.Fatalf("flag.Set: %v", err)
+	}
+	gen := rapid.SliceOfN(Optional(rapid.IntRange(0, 100)), 5, 5)
+	// A property that fails as soon as any value is present should shrink
+	// to a single present value of 0.
+	var last []optional.Optional[int]
+	rapid.Check(&failRecorder{T: t}, func(t *rapid.T) {
+		opts := gen.Draw(t, "opts")
+		for _, o := range opts {
+			if !o.IsEmpty() {
+				last = opts
+				t.Fatalf("present value")
+			}
+		}
+	})
+	present := 0
+	for _, o := range last {
+		if v, ok := o.Get(); ok {
+			present++
+			if v != 0 {
+				t.Fatalf("shrunk value: got %d, want 0", v)
+			}
+		}
+	}
+	if present != 1 {
+		t.Fatalf("shrunk counterexample %v: got %d present values, want 1", last, present)
+	}
+}
+
+func TestWeightedPanicsOnBadProbability(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Weighted: expected panic for probability 2")
+		}
+	}()
+	Weighted(rapid.Int(), 2)
+}
+
+// failRecorder lets rapid.Check fail without failing or logging to the
+// enclosing test.
+type failRecorder struct {
+	*testing.T
+}
+
+func (f *failRecorder) Errorf(format string, args ...any) {}
+func (f *failRecorder) Logf(format string, args ...any)   {}
+func (f *failRecorder) Log(args ...any)                   {}
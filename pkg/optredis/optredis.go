@@ -0,0 +1,96 @@
+// Package optredis connects optional.Optional values to
+// github.com/redis/go-redis/v9.
+//
+// Optionals already work as go-redis arguments through
+// encoding.BinaryMarshaler, and *Optional implements the hash Scanner
+// interface go-redis uses in HGetAll(...).Scan. This package adds Fields,
+// which leaves empty Optionals out of HSet, and Scan, which maps redis.Nil
+// to an empty Optional.
+package optredis
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/redis/go-redis/v9"
+)
+
+// Scan converts the result of a go-redis string command, such as
+// client.Get(ctx, key).Result() or client.HGet(ctx, key, field).Result(),
+// into an Optional. The value is decoded with UnmarshalBinary, matching
+// what go-redis stores for an Optional argument. A redis.Nil error, for a
+// missing key or field, yields an empty Optional and no error.
+func Scan[T any](s string, err error) (optional.Optional[T], error) {
+	var o optional.Optional[T]
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return o, nil
+		}
+		return o, err
+	}
+	err = o.UnmarshalBinary([]byte(s))
+	return o, err
+}
+
+// Fields returns the field/value pairs of the struct v, or of the struct v
+// points to, for passing to go-redis HSet or HMSet:
+//
+//	client.HSet(ctx, key, optredis.Fields(user)...)
+//
+// Like go-redis itself it uses the redis struct tag and skips untagged
+// fields. Empty Optionals and nil *Optional fields are left out, so they
+// are stored as absent hash fields rather than as an encoded empty value;
+// fields tagged omitempty are left out when zero. Present Optionals are
+// passed through for go-redis to encode with MarshalBinary.
+func Fields(v any) []any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	var fields []any
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("redis"), ",")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		switch {
+		case field.Type.Kind() == reflect.Pointer && isOptionalType(field.Type.Elem()):
+			if fv.IsNil() || isEmpty(fv) {
+				continue
+			}
+		case isOptionalType(field.Type):
+			if isEmpty(fv) {
+				continue
+			}
+		case strings.Contains(","+opts+",", ",omitempty,") && fv.IsZero():
+			continue
+		}
+		fields = append(fields, name, fv.Interface())
+	}
+	return fields
+}
+
+var optionalPkgPath = reflect.TypeFor[optional.Optional[int]]().PkgPath()
+
+// isOptionalType reports whether t is an optional.Optional[T] for some T.
+func isOptionalType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == optionalPkgPath &&
+		strings.HasPrefix(t.Name(), "Optional[")
+}
+
+// isEmpty reports whether the Optional, or non-nil pointer to one, in fv
+// is empty.
+func isEmpty(fv reflect.Value) bool {
+	return fv.Interface().(interface{ IsEmpty() bool }).IsEmpty()
+}
@@ -0,0 +1,96 @@
+package optredis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+type user struct {
+	Name  string                    `redis:"name"`
+	Email optional.Optional[string] `redis:"email"`
+	Age   optional.Optional[int]    `redis:"age"`
+	Nick  string                    `redis:"nick,omitempty"`
+	Notes string
+}
+
+func newRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestFields(t *testing.T) {
+	got := Fields(&user{Name: "ann", Age: optional.New(0), Notes: "x"})
+	want := []any{"name", "ann", "age", optional.New(0)}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Fields: got %v, want %v", got, want)
+	}
+
+	nick := optional.New("a")
+	ptrs := struct {
+		Email *optional.Optional[string] `redis:"email"`
+		Nick  *optional.Optional[string] `redis:"nick"`
+	}{Nick: &nick}
+	if got, want := Fields(ptrs), []any{"nick", &nick}; !slices.Equal(got, want) {
+		t.Fatalf("Fields with *Optional fields: got %v, want %v", got, want)
+	}
+	if got := Fields((*user)(nil)); got != nil {
+		t.Fatalf("Fields(nil): got %v, want nil", got)
+	}
+}
+
+func TestFieldsHash(t *testing.T) {
+	ctx := context.Background()
+	client := newRedis(t)
+
+	in := user{Name: "ann", Age: optional.New(0)}
+	if err := client.HSet(ctx, "user", Fields(in)...).Err(); err != nil {
+		t.Fatalf("HSet: unexpected error: %v", err)
+	}
+	if exists, _ := client.HExists(ctx, "user", "email").Result(); exists {
+		t.Fatalf("HSet: an empty Optional should be an absent field")
+	}
+
+	var out user
+	if err := client.HGetAll(ctx, "user").Scan(&out); err != nil {
+		t.Fatalf("Scan: unexpected error: %v", err)
+	}
+	if out.Name != "ann" || !out.Email.IsEmpty() || out.Age != optional.New(0) {
+		t.Fatalf("Scan: got %+v, want %+v", out, in)
+	}
+}
+
+func TestScan(t *testing.T) {
+	ctx := context.Background()
+	client := newRedis(t)
+
+	if err := client.Set(ctx, "k", optional.New(42), 0).Err(); err != nil {
+		t.Fatalf("Set: unexpected error: %v", err)
+	}
+	o, err := Scan[int](client.Get(ctx, "k").Result())
+	if v, ok := o.Get(); err != nil || !ok || v != 42 {
+		t.Fatalf("Scan: got (v=%v, ok=%v, err=%v), want (42, true, nil)", v, ok, err)
+	}
+
+	o, err = Scan[int](client.Get(ctx, "missing").Result())
+	if err != nil || !o.IsEmpty() {
+		t.Fatalf("Scan(missing): got (%v, %v), want an empty Optional", o, err)
+	}
+	o, err = Scan[int]("", fmt.Errorf("get: %w", redis.Nil))
+	if err != nil || !o.IsEmpty() {
+		t.Fatalf("Scan(wrapped redis.Nil): got (%v, %v), want an empty Optional", o, err)
+	}
+
+	boom := errors.New("boom")
+	if _, err := Scan[int]("", boom); err != boom {
+		t.Fatalf("Scan: got error %v, want %v", err, boom)
+	}
+}
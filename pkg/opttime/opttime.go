@@ -0,0 +1,230 @@
+// Package opttime provides Time, an optional timestamp that treats the zero
+// time.Time as empty. Timestamps are the most common nullable field, and
+// APIs and databases disagree on how to spell "no time": JSON null, an
+// empty string, "0001-01-01T00:00:00Z" or SQL NULL. Time reads all of them
+// as empty and writes null, an empty string or NULL.
+//
+// Time converts to and from optional.Optional[time.Time] with Optional and
+// FromOptional. Code that keeps optional.Optional[time.Time] fields can use
+// the Before and After functions directly.
+package opttime
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// Time is an optional time.Time. The zero value is empty.
+type Time struct {
+	o optional.Optional[time.Time]
+}
+
+// New returns a Time holding t, or an empty Time if t is the zero time.
+func New(t time.Time) Time {
+	if t.IsZero() {
+		return Time{}
+	}
+	return Time{optional.New(t)}
+}
+
+// FromPtr returns a Time holding *p, or an empty Time if p is nil or
+// points to the zero time.
+func FromPtr(p *time.Time) Time {
+	if p == nil {
+		return Time{}
+	}
+	return New(*p)
+}
+
+// FromOptional converts o into a Time. A present zero time becomes empty.
+func FromOptional(o optional.Optional[time.Time]) Time {
+	v, ok := o.Get()
+	if !ok {
+		return Time{}
+	}
+	return New(v)
+}
+
+// Optional converts t into an optional.Optional[time.Time].
+func (t Time) Optional() optional.Optional[time.Time] {
+	return t.o
+}
+
+// Get returns the time and whether t holds one.
+func (t Time) Get() (time.Time, bool) {
+	return t.o.Get()
+}
+
+// IsEmpty reports whether t holds no time.
+func (t Time) IsEmpty() bool {
+	return t.o.IsEmpty()
+}
+
+// IsZero is the same as IsEmpty. It lets encoding/json omit empty fields
+// tagged with omitzero.
+func (t Time) IsZero() bool {
+	return t.o.IsEmpty()
+}
+
+// Or returns the time, or def if t is empty.
+func (t Time) Or(def time.Time) time.Time {
+	return t.o.Or(def)
+}
+
+// ToPtr returns a pointer to a copy of the time, or nil if t is empty.
+func (t Time) ToPtr() *time.Time {
+	return t.o.ToPtr()
+}
+
+// Before reports whether t holds a time before u. An empty Time is neither
+// before nor after any time.
+func (t Time) Before(u time.Time) bool {
+	return Before(t.o, u)
+}
+
+// After reports whether t holds a time after u. An empty Time is neither
+// before nor after any time.
+func (t Time) After(u time.Time) bool {
+	return After(t.o, u)
+}
+
+// Before reports whether o holds a time before u. An empty Optional is
+// neither before nor after any time.
+func Before(o optional.Optional[time.Time], u time.Time) bool {
+	v, ok := o.Get()
+	return ok && v.Before(u)
+}
+
+// After reports whether o holds a time after u. An empty Optional is
+// neither before nor after any time.
+func After(o optional.Optional[time.Time], u time.Time) bool {
+	v, ok := o.Get()
+	return ok && v.After(u)
+}
+
+// Equal reports whether t and u are both empty or hold the same instant,
+// as time.Time.Equal does, regardless of location.
+func (t Time) Equal(u Time) bool {
+	v, ok := t.o.Get()
+	w, uok := u.o.Get()
+	if !ok || !uok {
+		return ok == uok
+	}
+	return v.Equal(w)
+}
+
+// String implements fmt.Stringer. Empty times render as "None", present
+// ones as "Some(<RFC 3339 time>)".
+func (t Time) String() string {
+	v, ok := t.o.Get()
+	if !ok {
+		return "None"
+	}
+	return "Some(" + v.Format(time.RFC3339Nano) + ")"
+}
+
+// MarshalJSON implements json.Marshaler. An empty Time is encoded as null,
+// a present one as an RFC 3339 string.
+func (t Time) MarshalJSON() ([]byte, error) {
+	v, ok := t.o.Get()
+	if !ok {
+		return []byte("null"), nil
+	}
+	return v.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null, "" and the zero time
+// make t empty; other strings are parsed as RFC 3339.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		*t = Time{}
+		return nil
+	}
+	var v time.Time
+	if err := v.UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("opttime: %w", err)
+	}
+	*t = New(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. An empty Time is encoded
+// as an empty string, a present one in RFC 3339 format.
+func (t Time) MarshalText() ([]byte, error) {
+	v, ok := t.o.Get()
+	if !ok {
+		return []byte{}, nil
+	}
+	return v.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An empty string and
+// the zero time make t empty; other text is parsed as RFC 3339.
+func (t *Time) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = Time{}
+		return nil
+	}
+	var v time.Time
+	if err := v.UnmarshalText(text); err != nil {
+		return fmt.Errorf("opttime: %w", err)
+	}
+	*t = New(v)
+	return nil
+}
+
+// sqlLayouts are the layouts Scan tries for timestamps that the driver
+// returns as text, as SQLite drivers do for columns without a declared
+// time type.
+var sqlLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Scan implements sql.Scanner. SQL NULL, empty text and the zero time
+// make t empty.
+// Besides time.Time, text in RFC 3339 or SQLite's "YYYY-MM-DD HH:MM:SS"
+// formats is accepted; text without a zone is read as UTC.
+func (t *Time) Scan(src any) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*t = Time{}
+		return nil
+	case time.Time:
+		*t = New(src)
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("opttime: cannot scan %T into Time", src)
+	}
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+	for _, layout := range sqlLayouts {
+		if v, err := time.Parse(layout, s); err == nil {
+			*t = New(v)
+			return nil
+		}
+	}
+	return fmt.Errorf("opttime: cannot parse %q as a time", s)
+}
+
+// Value implements driver.Valuer. An empty Time produces SQL NULL.
+func (t Time) Value() (driver.Value, error) {
+	v, ok := t.o.Get()
+	if !ok {
+		return nil, nil
+	}
+	return v, nil
+}
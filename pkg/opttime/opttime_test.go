@@ -0,0 +1,187 @@
+package opttime
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+	_ "modernc.org/sqlite"
+)
+
+var ts = time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+func TestConstructors(t *testing.T) {
+	if !New(time.Time{}).IsEmpty() {
+		t.Fatalf("New(zero time): expected empty")
+	}
+	if v, ok := New(ts).Get(); !ok || !v.Equal(ts) {
+		t.Fatalf("New: got (v=%v, ok=%v), want (%v, true)", v, ok, ts)
+	}
+	if !FromPtr(nil).IsEmpty() || !FromPtr(&time.Time{}).IsEmpty() {
+		t.Fatalf("FromPtr(nil or zero time): expected empty")
+	}
+	if !FromOptional(optional.New(time.Time{})).IsEmpty() {
+		t.Fatalf("FromOptional(present zero time): expected empty")
+	}
+	if got := FromOptional(optional.New(ts)).Optional(); got != optional.New(ts) {
+		t.Fatalf("Optional: got %v, want Some(%v)", got, ts)
+	}
+	if got := (Time{}).Or(ts); !got.Equal(ts) {
+		t.Fatalf("Or: got %v, want %v", got, ts)
+	}
+	if p := (Time{}).ToPtr(); p != nil {
+		t.Fatalf("ToPtr: got %v, want nil", p)
+	}
+}
+
+func TestBeforeAfterEqual(t *testing.T) {
+	earlier, later := ts.Add(-time.Hour), ts.Add(time.Hour)
+	o := New(ts)
+	if !o.Before(later) || o.Before(earlier) {
+		t.Fatalf("Before: got (%v, %v), want (true, false)", o.Before(later), o.Before(earlier))
+	}
+	if !o.After(earlier) || o.After(later) {
+		t.Fatalf("After: got (%v, %v), want (true, false)", o.After(earlier), o.After(later))
+	}
+
+	var empty Time
+	if empty.Before(later) || empty.After(earlier) {
+		t.Fatalf("Before/After on empty: expected false")
+	}
+
+	present, none := optional.New(ts), optional.Empty[time.Time]()
+	if !Before(present, later) || Before(present, earlier) {
+		t.Fatalf("Before(Optional): got (%v, %v), want (true, false)", Before(present, later), Before(present, earlier))
+	}
+	if !After(present, earlier) || After(present, later) {
+		t.Fatalf("After(Optional): got (%v, %v), want (true, false)", After(present, earlier), After(present, later))
+	}
+	if Before(none, later) || After(none, earlier) {
+		t.Fatalf("Before/After on an empty Optional: expected false")
+	}
+
+	if !o.Equal(New(ts.In(time.FixedZone("X", 3600)))) {
+		t.Fatalf("Equal: the same instant in another location should be equal")
+	}
+	if o.Equal(empty) || !empty.Equal(Time{}) {
+		t.Fatalf("Equal: got (%v, %v), want (false, true)", o.Equal(empty), empty.Equal(Time{}))
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type event struct {
+		At   Time `json:"at"`
+		Seen Time `json:"seen,omitzero"`
+	}
+
+	data, err := json.Marshal(event{At: New(ts)})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := `{"at":"2024-05-01T12:30:00Z"}`; string(data) != want {
+		t.Fatalf("Marshal: got %s, want %s", data, want)
+	}
+	if data, _ := json.Marshal(event{}); string(data) != `{"at":null}` {
+		t.Fatalf("Marshal(empty): got %s, want {\"at\":null}", data)
+	}
+
+	for _, in := range []string{`{"at":null}`, `{"at":""}`, `{"at":"0001-01-01T00:00:00Z"}`} {
+		e := event{At: New(ts)}
+		if err := json.Unmarshal([]byte(in), &e); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", in, err)
+		}
+		if !e.At.IsEmpty() {
+			t.Fatalf("Unmarshal(%s): got %v, want None", in, e.At)
+		}
+	}
+
+	var e event
+	if err := json.Unmarshal([]byte(`{"at":"2024-05-01T14:30:00+02:00"}`), &e); err != nil || !e.At.Equal(New(ts)) {
+		t.Fatalf("Unmarshal: got (%v, %v), want (Some(%v), nil)", e.At, err, ts)
+	}
+	if err := json.Unmarshal([]byte(`{"at":"yesterday"}`), &e); err == nil {
+		t.Fatalf("Unmarshal: expected error for an invalid time")
+	}
+}
+
+func TestText(t *testing.T) {
+	text, err := New(ts).MarshalText()
+	if err != nil || string(text) != "2024-05-01T12:30:00Z" {
+		t.Fatalf("MarshalText: got (%q, %v), want (\"2024-05-01T12:30:00Z\", nil)", text, err)
+	}
+	if text, _ := (Time{}).MarshalText(); len(text) != 0 {
+		t.Fatalf("MarshalText(empty): got %q, want \"\"", text)
+	}
+
+	var o Time
+	if err := o.UnmarshalText([]byte("2024-05-01T12:30:00Z")); err != nil || !o.Equal(New(ts)) {
+		t.Fatalf("UnmarshalText: got (%v, %v), want (Some(%v), nil)", o, err, ts)
+	}
+	if err := o.UnmarshalText(nil); err != nil || !o.IsEmpty() {
+		t.Fatalf("UnmarshalText(\"\"): got (%v, %v), want (None, nil)", o, err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name string
+		src  any
+		want Time
+	}{
+		{name: "nil", src: nil, want: Time{}},
+		{name: "time", src: ts, want: New(ts)},
+		{name: "zero time", src: time.Time{}, want: Time{}},
+		{name: "rfc3339", src: "2024-05-01T12:30:00Z", want: New(ts)},
+		{name: "sqlite", src: []byte("2024-05-01 12:30:00"), want: New(ts)},
+		{name: "empty text", src: "", want: Time{}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := New(time.Now())
+			if err := o.Scan(tc.src); err != nil || !o.Equal(tc.want) {
+				t.Fatalf("Scan(%v): got (%v, %v), want (%v, nil)", tc.src, o, err, tc.want)
+			}
+		})
+	}
+
+	var o Time
+	if err := o.Scan(42); err == nil {
+		t.Fatalf("Scan(int): expected error")
+	}
+	if err := o.Scan("soon"); err == nil {
+		t.Fatalf("Scan(invalid text): expected error")
+	}
+}
+
+func TestDatabase(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE events (id INTEGER, at DATETIME, note TEXT)`); err != nil {
+		t.Fatalf("CREATE TABLE: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO events VALUES (1, ?, NULL), (2, ?, '2024-05-01 12:30:00')`, New(ts), Time{}); err != nil {
+		t.Fatalf("INSERT: %v", err)
+	}
+
+	var at, note Time
+	if err := db.QueryRow(`SELECT at, note FROM events WHERE id = 1`).Scan(&at, &note); err != nil {
+		t.Fatalf("Scan row 1: %v", err)
+	}
+	if !at.Equal(New(ts)) || !note.IsEmpty() {
+		t.Fatalf("row 1: got (at=%v, note=%v), want (Some(%v), None)", at, note, ts)
+	}
+
+	var isNull bool
+	if err := db.QueryRow(`SELECT at IS NULL, note FROM events WHERE id = 2`).Scan(&isNull, &note); err != nil {
+		t.Fatalf("Scan row 2: %v", err)
+	}
+	if !isNull || !note.Equal(New(ts)) {
+		t.Fatalf("row 2: got (at IS NULL=%v, note=%v), want (true, Some(%v))", isNull, note, ts)
+	}
+}
@@ -0,0 +1,97 @@
+// Package sqlconv converts between the database/sql null types and optional.Optional.
+package sqlconv
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+// FromNullString converts a sql.NullString into an Optional.
+func FromNullString(n sql.NullString) optional.Optional[string] {
+	return optional.FromOk(n.String, n.Valid)
+}
+
+// ToNullString converts an Optional into a sql.NullString.
+func ToNullString(o optional.Optional[string]) sql.NullString {
+	v, ok := o.Get()
+	return sql.NullString{String: v, Valid: ok}
+}
+
+// FromNullInt64 converts a sql.NullInt64 into an Optional.
+func FromNullInt64(n sql.NullInt64) optional.Optional[int64] {
+	return optional.FromOk(n.Int64, n.Valid)
+}
+
+// ToNullInt64 converts an Optional into a sql.NullInt64.
+func ToNullInt64(o optional.Optional[int64]) sql.NullInt64 {
+	v, ok := o.Get()
+	return sql.NullInt64{Int64: v, Valid: ok}
+}
+
+// FromNullInt32 converts a sql.NullInt32 into an Optional.
+func FromNullInt32(n sql.NullInt32) optional.Optional[int32] {
+	return optional.FromOk(n.Int32, n.Valid)
+}
+
+// ToNullInt32 converts an Optional into a sql.NullInt32.
+func ToNullInt32(o optional.Optional[int32]) sql.NullInt32 {
+	v, ok := o.Get()
+	return sql.NullInt32{Int32: v, Valid: ok}
+}
+
+// FromNullInt16 converts a sql.NullInt16 into an Optional.
+func FromNullInt16(n sql.NullInt16) optional.Optional[int16] {
+	return optional.FromOk(n.Int16, n.Valid)
+}
+
+// ToNullInt16 converts an Optional into a sql.NullInt16.
+func ToNullInt16(o optional.Optional[int16]) sql.NullInt16 {
+	v, ok := o.Get()
+	return sql.NullInt16{Int16: v, Valid: ok}
+}
+
+// FromNullByte converts a sql.NullByte into an Optional.
+func FromNullByte(n sql.NullByte) optional.Optional[byte] {
+	return optional.FromOk(n.Byte, n.Valid)
+}
+
+// ToNullByte converts an Optional into a sql.NullByte.
+func ToNullByte(o optional.Optional[byte]) sql.NullByte {
+	v, ok := o.Get()
+	return sql.NullByte{Byte: v, Valid: ok}
+}
+
+// FromNullFloat64 converts a sql.NullFloat64 into an Optional.
+func FromNullFloat64(n sql.NullFloat64) optional.Optional[float64] {
+	return optional.FromOk(n.Float64, n.Valid)
+}
+
+// ToNullFloat64 converts an Optional into a sql.NullFloat64.
+func ToNullFloat64(o optional.Optional[float64]) sql.NullFloat64 {
+	v, ok := o.Get()
+	return sql.NullFloat64{Float64: v, Valid: ok}
+}
+
+// FromNullBool converts a sql.NullBool into an Optional.
+func FromNullBool(n sql.NullBool) optional.Optional[bool] {
+	return optional.FromOk(n.Bool, n.Valid)
+}
+
+// ToNullBool converts an Optional into a sql.NullBool.
+func ToNullBool(o optional.Optional[bool]) sql.NullBool {
+	v, ok := o.Get()
+	return sql.NullBool{Bool: v, Valid: ok}
+}
+
+// FromNullTime converts a sql.NullTime into an Optional.
+func FromNullTime(n sql.NullTime) optional.Optional[time.Time] {
+	return optional.FromOk(n.Time, n.Valid)
+}
+
+// ToNullTime converts an Optional into a sql.NullTime.
+func ToNullTime(o optional.Optional[time.Time]) sql.NullTime {
+	v, ok := o.Get()
+	return sql.NullTime{Time: v, Valid: ok}
+}
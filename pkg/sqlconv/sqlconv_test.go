@@ -0,0 +1,52 @@
+package sqlconv
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/Palladium-blockchain/go-optional/pkg/optional"
+)
+
+func TestNullStringRoundTrip(t *testing.T) {
+	cases := []sql.NullString{
+		{},
+		{String: "", Valid: true},
+		{String: "x", Valid: true},
+	}
+
+	for _, n := range cases {
+		if got := ToNullString(FromNullString(n)); got != n {
+			t.Fatalf("round trip: got %+v, want %+v", got, n)
+		}
+	}
+}
+
+func TestFromNullInvalidIsEmpty(t *testing.T) {
+	if o := FromNullInt64(sql.NullInt64{Int64: 5}); !o.IsEmpty() {
+		t.Fatalf("FromNullInt64(invalid) should be empty")
+	}
+	if o := FromNullBool(sql.NullBool{Bool: true}); !o.IsEmpty() {
+		t.Fatalf("FromNullBool(invalid) should be empty")
+	}
+}
+
+func TestToNull(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	if got := ToNullInt32(optional.New[int32](0)); got != (sql.NullInt32{Valid: true}) {
+		t.Fatalf("ToNullInt32(0): got %+v, want {Int32:0 Valid:true}", got)
+	}
+	if got := ToNullFloat64(optional.Empty[float64]()); got != (sql.NullFloat64{}) {
+		t.Fatalf("ToNullFloat64(empty): got %+v, want {}", got)
+	}
+	if got := ToNullTime(optional.New(ts)); !got.Valid || !got.Time.Equal(ts) {
+		t.Fatalf("ToNullTime: got %+v, want {Time:%v Valid:true}", got, ts)
+	}
+	if got := ToNullInt16(optional.New[int16](-2)); got != (sql.NullInt16{Int16: -2, Valid: true}) {
+		t.Fatalf("ToNullInt16: got %+v, want {Int16:-2 Valid:true}", got)
+	}
+	if got := ToNullByte(optional.New[byte](9)); got != (sql.NullByte{Byte: 9, Valid: true}) {
+		t.Fatalf("ToNullByte: got %+v, want {Byte:9 Valid:true}", got)
+	}
+}